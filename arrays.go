@@ -1,8 +1,9 @@
 package fusion
 
 import (
-	"fmt"
+	"io"
 	"math/rand"
+	"strings"
 	"time"
 )
 
@@ -73,20 +74,24 @@ func Every[T any](arr []T, predicate func(T, int, []T) bool) bool {
 	return true
 }
 
-// Fill modifies a slice by filling it with a specified value from a start index to an end index
-func Fill(arr []interface{}, value interface{}, start int, end int) {
-	length := len(arr)
-	if start < 0 {
-		start = 0
-	}
-	if end > length {
-		end = length
-	}
+// Fill sets the elements of a slice from a start index up to, but not including, an end index to value.
+// Indices follow SliceStrict: negative indices count back from the end of the slice, and an invalid range panics.
+func Fill[T any](arr []T, value T, start int, end int) {
+	start, end = strictRange(len(arr), start, end)
 	for i := start; i < end; i++ {
 		arr[i] = value
 	}
 }
 
+// FillFunc sets the elements of a slice from a start index up to, but not including, an end index
+// to the result of calling fn with each index. Indices follow SliceStrict.
+func FillFunc[T any](arr []T, fn func(i int) T, start int, end int) {
+	start, end = strictRange(len(arr), start, end)
+	for i := start; i < end; i++ {
+		arr[i] = fn(i)
+	}
+}
+
 // Filter filters the elements of a slice based on a predicate function.
 // The predicate function is invoked with three arguments: (value, index, arg),
 // and should return a boolean indicating whether the value should be included in the result.
@@ -102,10 +107,74 @@ func Filter[T any](arr []T, predicate func(int, T, interface{}) bool, arg interf
 	return result
 }
 
+// Find returns the first element in a slice that satisfies the predicate,
+// and a boolean indicating whether such an element was found.
+func Find[T any](arr []T, predicate func(T) bool) (T, bool) {
+	if i := FindIndex(arr, predicate); i >= 0 {
+		return arr[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindAll returns every element in a slice that satisfies the predicate, in order.
+func FindAll[T any](arr []T, predicate func(T) bool) []T {
+	var result []T
+	for _, val := range arr {
+		if predicate(val) {
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
 // FindIndex returns the index of the first element in a slice that satisfies the provided testing function
-func FindIndex(arr []interface{}, predicate func(interface{}) bool) int {
+func FindIndex[T any](arr []T, predicate func(T) bool) int {
+	return FindIndexFrom(arr, predicate, 0)
+}
+
+// FindIndexFrom is like FindIndex, but starts searching at fromIndex.
+// A negative fromIndex is an offset from the end of the slice.
+func FindIndexFrom[T any](arr []T, predicate func(T) bool, fromIndex int) int {
+	for i := forwardFromIndex(len(arr), fromIndex); i < len(arr); i++ {
+		if predicate(arr[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindIndices returns the indexes of every element in a slice that satisfies the predicate, in ascending order.
+func FindIndices[T any](arr []T, predicate func(T) bool) []int {
+	var indices []int
 	for i, val := range arr {
 		if predicate(val) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// FindLast returns the last element in a slice that satisfies the predicate,
+// and a boolean indicating whether such an element was found.
+func FindLast[T any](arr []T, predicate func(T) bool) (T, bool) {
+	if i := FindLastIndex(arr, predicate); i >= 0 {
+		return arr[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindLastIndex returns the index of the last element in a slice that satisfies the predicate, or -1 if none does.
+func FindLastIndex[T any](arr []T, predicate func(T) bool) int {
+	return FindLastIndexFrom(arr, predicate, len(arr)-1)
+}
+
+// FindLastIndexFrom is like FindLastIndex, but searches backwards starting at fromIndex.
+// A negative fromIndex is an offset from the end of the slice.
+func FindLastIndexFrom[T any](arr []T, predicate func(T) bool, fromIndex int) int {
+	for i := backwardFromIndex(len(arr), fromIndex); i >= 0; i-- {
+		if predicate(arr[i]) {
 			return i
 		}
 	}
@@ -126,6 +195,17 @@ func Flatten(arr []interface{}) []interface{} {
 	return flattened
 }
 
+// GroupBy groups the elements of a slice by the key returned by keyFn.
+// Elements keep their original order within each group.
+func GroupBy[T any, K comparable](arr []T, keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, item := range arr {
+		key := keyFn(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
 // Includes checks if a given value is present in the slice.
 // It returns true if the value is found, otherwise false.
 func Includes[T comparable](arr []T, value T) bool {
@@ -137,6 +217,17 @@ func Includes[T comparable](arr []T, value T) bool {
 	return false
 }
 
+// IndexOf returns the index of the first occurrence of value in a slice, or -1 if it is not present.
+func IndexOf[T comparable](arr []T, value T) int {
+	return IndexOfFrom(arr, value, 0)
+}
+
+// IndexOfFrom is like IndexOf, but starts searching at fromIndex.
+// A negative fromIndex is an offset from the end of the slice.
+func IndexOfFrom[T comparable](arr []T, value T, fromIndex int) int {
+	return FindIndexFrom(arr, func(item T) bool { return item == value }, fromIndex)
+}
+
 // Intersection returns an array containing the unique values that are present in all of the input arrays.
 func Intersection(arrays ...[]interface{}) []interface{} {
 	// Count occurrences of each element
@@ -164,18 +255,119 @@ func Intersection(arrays ...[]interface{}) []interface{} {
 
 // Join concatenates all elements of an array into a single string using the provided separator.
 func Join(arr []interface{}, separator string) string {
-	if len(arr) == 0 {
-		return ""
+	return JoinBy(arr, separator, ToString)
+}
+
+// JoinBy concatenates all elements of a slice into a single string using the provided separator,
+// converting each element to a string with the format function.
+func JoinBy[T any](arr []T, separator string, format func(T) string) string {
+	return JoinWith(arr, JoinOptions{Separator: separator}, format)
+}
+
+// JoinOptions configures JoinWith and JoinTo.
+type JoinOptions struct {
+	// Separator is written between elements.
+	Separator string
+	// LastSeparator, if set, is written between the last two elements instead of Separator,
+	// as in "a, b and c".
+	LastSeparator string
+	// Prefix is written before the first element.
+	Prefix string
+	// Suffix is written after the last element.
+	Suffix string
+	// Limit, if positive, is the maximum number of elements written.
+	// When elements are left out, Ellipsis is written in their place.
+	Limit int
+	// Ellipsis replaces the elements left out because of Limit. It defaults to "...".
+	Ellipsis string
+}
+
+// JoinTo writes all elements of a slice to w as configured by opts, converting each element
+// to a string with the format function, or with ToString if format is nil.
+// It returns the number of bytes written and the first error returned by w.
+func JoinTo[T any](w io.Writer, arr []T, opts JoinOptions, format func(T) string) (int, error) {
+	if format == nil {
+		format = func(value T) string { return ToString(value) }
 	}
 
-	var result string
-	for i, elem := range arr {
+	count := len(arr)
+	truncated := opts.Limit > 0 && count > opts.Limit
+	if truncated {
+		count = opts.Limit
+	}
+
+	jw := joinWriter{w: w}
+	jw.write(opts.Prefix)
+	for i := 0; i < count; i++ {
 		if i > 0 {
-			result += separator
+			if i == count-1 && !truncated && opts.LastSeparator != "" {
+				jw.write(opts.LastSeparator)
+			} else {
+				jw.write(opts.Separator)
+			}
+		}
+		jw.write(format(arr[i]))
+	}
+	if truncated {
+		ellipsis := opts.Ellipsis
+		if ellipsis == "" {
+			ellipsis = "..."
 		}
-		result += fmt.Sprintf("%v", elem)
+		if count > 0 {
+			jw.write(opts.Separator)
+		}
+		jw.write(ellipsis)
+	}
+	jw.write(opts.Suffix)
+
+	return jw.n, jw.err
+}
+
+// JoinWith concatenates all elements of a slice into a single string as configured by opts,
+// converting each element to a string with the format function, or with ToString if format is nil.
+func JoinWith[T any](arr []T, opts JoinOptions, format func(T) string) string {
+	var b strings.Builder
+	_, _ = JoinTo(&b, arr, opts, format)
+	return b.String()
+}
+
+// joinWriter writes strings to an io.Writer, counting bytes and stopping at the first error.
+type joinWriter struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+func (jw *joinWriter) write(s string) {
+	if jw.err != nil || s == "" {
+		return
 	}
+	var n int
+	n, jw.err = io.WriteString(jw.w, s)
+	jw.n += n
+}
 
+// LastIndexOf returns the index of the last occurrence of value in a slice, or -1 if it is not present.
+func LastIndexOf[T comparable](arr []T, value T) int {
+	return LastIndexOfFrom(arr, value, len(arr)-1)
+}
+
+// LastIndexOfFrom is like LastIndexOf, but searches backwards starting at fromIndex.
+// A negative fromIndex is an offset from the end of the slice.
+func LastIndexOfFrom[T comparable](arr []T, value T, fromIndex int) int {
+	return FindLastIndexFrom(arr, func(item T) bool { return item == value }, fromIndex)
+}
+
+// Make returns a slice of length n whose elements are the results of calling fn with each index.
+// It panics if n is negative.
+func Make[T any](n int, fn func(i int) T) []T {
+	if n < 0 {
+		panic("fusion: negative slice length")
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = fn(i)
+	}
 	return result
 }
 
@@ -273,6 +465,14 @@ func Slice[T any](arr []T, start, end int) []T {
 	return arr[start:end]
 }
 
+// SliceStrict returns a portion of a slice from a start index up to, but not including, an end index.
+// Unlike Slice, negative indices count back from the end of the slice, so -1 is the last element,
+// and it panics if either index is out of range or start comes after end instead of clamping them.
+func SliceStrict[T any](arr []T, start, end int) []T {
+	start, end = strictRange(len(arr), start, end)
+	return arr[start:end]
+}
+
 // Some checks if at least one element in the slice satisfies the given predicate.
 // The predicate is invoked with three arguments: (value, index, array).
 // It returns true if the predicate returns true for any element, otherwise false.
@@ -337,3 +537,17 @@ func Zip[T1, T2 interface{}](slice1 []T1, slice2 []T2) [][]interface{} {
 
 	return zipped
 }
+
+// strictRange resolves negative indices against length and panics if the range is invalid.
+func strictRange(length, start, end int) (int, int) {
+	if start < 0 {
+		start += length
+	}
+	if end < 0 {
+		end += length
+	}
+	if start < 0 || end > length || start > end {
+		panic("fusion: slice range out of bounds")
+	}
+	return start, end
+}
@@ -1,6 +1,8 @@
 package fusion
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
@@ -179,12 +181,20 @@ func TestFill(t *testing.T) {
 			expected: []interface{}{},
 		},
 		{
-			name:     "start and end out of range",
+			name:     "negative indices",
 			arr:      []interface{}{1, 2, 3, 4, 5},
 			value:    "x",
-			start:    -1,
-			end:      10,
-			expected: []interface{}{"x", "x", "x", "x", "x"},
+			start:    -3,
+			end:      -1,
+			expected: []interface{}{1, 2, "x", "x", 5},
+		},
+		{
+			name:     "whole slice",
+			arr:      []interface{}{1, 2, 3},
+			value:    nil,
+			start:    -3,
+			end:      3,
+			expected: []interface{}{nil, nil, nil},
 		},
 	}
 
@@ -196,6 +206,52 @@ func TestFill(t *testing.T) {
 			}
 		})
 	}
+
+	typed := []string{"a", "b", "c"}
+	Fill(typed, "z", 1, 3)
+	if expected := []string{"a", "z", "z"}; !reflect.DeepEqual(typed, expected) {
+		t.Errorf("expected %v but got %v", expected, typed)
+	}
+}
+
+func TestFillFunc(t *testing.T) {
+	t.Parallel()
+
+	arr := []int{0, 0, 0, 0, 0}
+	FillFunc(arr, func(i int) int { return i * i }, 1, -1)
+	if expected := []int{0, 1, 4, 9, 0}; !reflect.DeepEqual(arr, expected) {
+		t.Errorf("expected %v but got %v", expected, arr)
+	}
+}
+
+func TestFillInvalidRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		start int
+		end   int
+	}{
+		{name: "start after end", start: 3, end: 2},
+		{name: "end past length", start: 0, end: 6},
+		{name: "start before beginning", start: -6, end: 2},
+		{name: "negative start after end", start: -1, end: 2},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			arr := []int{1, 2, 3, 4, 5}
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected Fill to panic")
+				}
+				if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(arr, expected) {
+					t.Errorf("expected %v to be unchanged but got %v", expected, arr)
+				}
+			}()
+			Fill(arr, 0, testCase.start, testCase.end)
+		})
+	}
 }
 
 func TestFilter(t *testing.T) {
@@ -284,6 +340,155 @@ func TestFindIndex(t *testing.T) {
 	}
 }
 
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	testCases := []struct {
+		name          string
+		input         []int
+		expected      int
+		expectedFound bool
+		expectedLast  int
+	}{
+		{
+			name:          "matches found",
+			input:         []int{1, 2, 3, 4, 5},
+			expected:      2,
+			expectedFound: true,
+			expectedLast:  4,
+		},
+		{
+			name:          "no match",
+			input:         []int{1, 3, 5},
+			expected:      0,
+			expectedFound: false,
+			expectedLast:  0,
+		},
+		{
+			name:          "empty array",
+			input:         []int{},
+			expected:      0,
+			expectedFound: false,
+			expectedLast:  0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, found := Find(testCase.input, isEven)
+			if result != testCase.expected || found != testCase.expectedFound {
+				t.Errorf("Find: expected (%v, %v) but got (%v, %v)", testCase.expected, testCase.expectedFound, result, found)
+			}
+			result, found = FindLast(testCase.input, isEven)
+			if result != testCase.expectedLast || found != testCase.expectedFound {
+				t.Errorf("FindLast: expected (%v, %v) but got (%v, %v)", testCase.expectedLast, testCase.expectedFound, result, found)
+			}
+		})
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	testCases := []struct {
+		name            string
+		input           []int
+		expected        []int
+		expectedIndices []int
+	}{
+		{
+			name:            "matches found",
+			input:           []int{1, 2, 3, 4, 6},
+			expected:        []int{2, 4, 6},
+			expectedIndices: []int{1, 3, 4},
+		},
+		{
+			name:            "no match",
+			input:           []int{1, 3},
+			expected:        nil,
+			expectedIndices: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := FindAll(testCase.input, isEven); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("FindAll: expected %v but got %v", testCase.expected, result)
+			}
+			if result := FindIndices(testCase.input, isEven); !reflect.DeepEqual(result, testCase.expectedIndices) {
+				t.Errorf("FindIndices: expected %v but got %v", testCase.expectedIndices, result)
+			}
+		})
+	}
+}
+
+func TestFindIndexFrom(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"a", "b", "a", "b"}
+	isB := func(value string) bool { return value == "b" }
+
+	testCases := []struct {
+		name              string
+		fromIndex         int
+		expected          int
+		expectedLastIndex int
+	}{
+		{
+			name:              "from start",
+			fromIndex:         0,
+			expected:          1,
+			expectedLastIndex: -1,
+		},
+		{
+			name:              "from middle",
+			fromIndex:         2,
+			expected:          3,
+			expectedLastIndex: 1,
+		},
+		{
+			name:              "negative offset",
+			fromIndex:         -2,
+			expected:          3,
+			expectedLastIndex: 1,
+		},
+		{
+			name:              "negative offset beyond start",
+			fromIndex:         -10,
+			expected:          1,
+			expectedLastIndex: -1,
+		},
+		{
+			name:              "beyond end",
+			fromIndex:         10,
+			expected:          -1,
+			expectedLastIndex: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if index := FindIndexFrom(input, isB, testCase.fromIndex); index != testCase.expected {
+				t.Errorf("FindIndexFrom: expected index %d but got %d", testCase.expected, index)
+			}
+			if index := FindLastIndexFrom(input, isB, testCase.fromIndex); index != testCase.expectedLastIndex {
+				t.Errorf("FindLastIndexFrom: expected index %d but got %d", testCase.expectedLastIndex, index)
+			}
+		})
+	}
+
+	if index := FindLastIndex(input, isB); index != 3 {
+		t.Errorf("FindLastIndex: expected index 3 but got %d", index)
+	}
+	if index := FindLastIndex([]string{}, isB); index != -1 {
+		t.Errorf("FindLastIndex: expected index -1 but got %d", index)
+	}
+}
+
 func TestFlatten(t *testing.T) {
 	t.Parallel()
 
@@ -319,6 +524,36 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		arr      []string
+		expected map[int][]string
+	}{
+		{
+			name:     "group by length",
+			arr:      []string{"one", "two", "three", "four", "five", "six"},
+			expected: map[int][]string{3: {"one", "two", "six"}, 4: {"four", "five"}, 5: {"three"}},
+		},
+		{
+			name:     "empty slice",
+			arr:      []string{},
+			expected: map[int][]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			groups := GroupBy(tc.arr, func(s string) int { return len(s) })
+			if !reflect.DeepEqual(groups, tc.expected) {
+				t.Errorf("expected %v but got %v", tc.expected, groups)
+			}
+		})
+	}
+}
+
 func TestIncludes(t *testing.T) {
 	t.Parallel()
 
@@ -352,6 +587,70 @@ func TestIncludes(t *testing.T) {
 	}
 }
 
+func TestIndexOf(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 1, 2}
+
+	testCases := []struct {
+		name              string
+		value             int
+		fromIndex         int
+		expected          int
+		expectedLastIndex int
+	}{
+		{
+			name:              "from start",
+			value:             2,
+			fromIndex:         0,
+			expected:          1,
+			expectedLastIndex: -1,
+		},
+		{
+			name:              "negative offset",
+			value:             1,
+			fromIndex:         -2,
+			expected:          2,
+			expectedLastIndex: 2,
+		},
+		{
+			name:              "negative offset beyond start",
+			value:             1,
+			fromIndex:         -10,
+			expected:          0,
+			expectedLastIndex: 0,
+		},
+		{
+			name:              "value not found",
+			value:             3,
+			fromIndex:         0,
+			expected:          -1,
+			expectedLastIndex: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if index := IndexOfFrom(input, testCase.value, testCase.fromIndex); index != testCase.expected {
+				t.Errorf("IndexOfFrom: expected index %d but got %d", testCase.expected, index)
+			}
+			if index := LastIndexOfFrom(input, testCase.value, testCase.fromIndex); index != testCase.expectedLastIndex {
+				t.Errorf("LastIndexOfFrom: expected index %d but got %d", testCase.expectedLastIndex, index)
+			}
+		})
+	}
+
+	if index := IndexOf(input, 2); index != 1 {
+		t.Errorf("IndexOf: expected index 1 but got %d", index)
+	}
+	if index := LastIndexOf(input, 1); index != 2 {
+		t.Errorf("LastIndexOf: expected index 2 but got %d", index)
+	}
+	if index := LastIndexOf([]int{}, 1); index != -1 {
+		t.Errorf("LastIndexOf: expected index -1 but got %d", index)
+	}
+}
+
 func TestIntersection(t *testing.T) {
 	t.Parallel()
 
@@ -401,7 +700,7 @@ func TestIntersection(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := Intersection(testCase.arrays...)
-			if !interfaceSliceEqual(result, testCase.expected) {
+			if !IsEqualWith(result, testCase.expected, EqualOptions{IgnoreOrder: true, NilEqualsEmpty: true}) {
 				t.Errorf("expected %v but got %v. %T %T", testCase.expected, result, testCase.expected, result)
 			}
 		})
@@ -453,6 +752,138 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinBy(t *testing.T) {
+	t.Parallel()
+
+	result := JoinBy([]float64{1.5, 2, 3.25}, "; ", func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	})
+	if expected := "1.50; 2.00; 3.25"; result != expected {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestJoinWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		opts     JoinOptions
+		expected string
+	}{
+		{
+			name:     "last separator",
+			input:    []string{"a", "b", "c"},
+			opts:     JoinOptions{Separator: ", ", LastSeparator: " and "},
+			expected: "a, b and c",
+		},
+		{
+			name:     "last separator with two elements",
+			input:    []string{"a", "b"},
+			opts:     JoinOptions{Separator: ", ", LastSeparator: " and "},
+			expected: "a and b",
+		},
+		{
+			name:     "prefix and suffix",
+			input:    []string{"a", "b"},
+			opts:     JoinOptions{Separator: ",", Prefix: "[", Suffix: "]"},
+			expected: "[a,b]",
+		},
+		{
+			name:     "prefix and suffix on empty slice",
+			input:    []string{},
+			opts:     JoinOptions{Separator: ",", Prefix: "[", Suffix: "]"},
+			expected: "[]",
+		},
+		{
+			name:     "limit with default ellipsis",
+			input:    []string{"a", "b", "c", "d"},
+			opts:     JoinOptions{Separator: ", ", LastSeparator: " and ", Limit: 2},
+			expected: "a, b, ...",
+		},
+		{
+			name:     "limit with custom ellipsis",
+			input:    []string{"a", "b", "c"},
+			opts:     JoinOptions{Separator: " ", Limit: 1, Ellipsis: "(+2 more)"},
+			expected: "a (+2 more)",
+		},
+		{
+			name:     "limit not reached",
+			input:    []string{"a", "b"},
+			opts:     JoinOptions{Separator: ", ", Limit: 2},
+			expected: "a, b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := JoinWith(testCase.input, testCase.opts, nil)
+			if result != testCase.expected {
+				t.Errorf("expected %q but got %q", testCase.expected, result)
+			}
+		})
+	}
+}
+
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errors.New("write failed")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func TestJoinTo(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	n, err := JoinTo(&b, []int{1, 2, 3}, JoinOptions{Separator: "-"}, nil)
+	if err != nil || n != 5 || b.String() != "1-2-3" {
+		t.Errorf("expected (5, nil) and 1-2-3 but got (%d, %v) and %q", n, err, b.String())
+	}
+
+	n, err = JoinTo(&failingWriter{limit: 3}, []int{1, 2, 3}, JoinOptions{Separator: "-"}, nil)
+	if err == nil || n != 3 {
+		t.Errorf("expected the write error after 3 bytes but got (%d, %v)", n, err)
+	}
+}
+
+func TestMake(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected []string
+	}{
+		{name: "generated", n: 3, expected: []string{"0", "1", "2"}},
+		{name: "empty", n: 0, expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Make(testCase.n, strconv.Itoa)
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a negative length to panic")
+		}
+	}()
+	Make(-1, strconv.Itoa)
+}
+
 func TestMap(t *testing.T) {
 	t.Parallel()
 
@@ -796,6 +1227,42 @@ func TestSlice(t *testing.T) {
 	}
 }
 
+func TestSliceStrict(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		start    int
+		end      int
+		expected []int
+		panics   bool
+	}{
+		{name: "specified bounds", input: []int{1, 2, 3, 4, 5}, start: 1, end: 4, expected: []int{2, 3, 4}},
+		{name: "negative start", input: []int{1, 2, 3, 4, 5}, start: -2, end: 5, expected: []int{4, 5}},
+		{name: "negative end", input: []int{1, 2, 3, 4, 5}, start: 0, end: -1, expected: []int{1, 2, 3, 4}},
+		{name: "empty range", input: []int{1, 2, 3}, start: 3, end: 3, expected: []int{}},
+		{name: "empty slice", input: []int{}, start: 0, end: 0, expected: []int{}},
+		{name: "start after end", input: []int{1, 2, 3}, start: 2, end: 1, panics: true},
+		{name: "end past length", input: []int{1, 2, 3}, start: 0, end: 4, panics: true},
+		{name: "start before beginning", input: []int{1, 2, 3}, start: -4, end: 3, panics: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if panicked := recover() != nil; panicked != testCase.panics {
+					t.Errorf("expected panic %v but got %v", testCase.panics, panicked)
+				}
+			}()
+			result := SliceStrict(testCase.input, testCase.start, testCase.end)
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
 func TestSome(t *testing.T) {
 	t.Parallel()
 
@@ -864,10 +1331,7 @@ func TestUnion(t *testing.T) {
 			inputSlices = append(inputSlices, testCase.input...)
 
 			result := Union(inputSlices...)
-			sortSlice(result)            // Sort the result for comparison
-			sortSlice(testCase.expected) // Sort the expected slice for comparison
-
-			if !reflect.DeepEqual(result, testCase.expected) {
+			if !IsEqualWith(result, testCase.expected, EqualOptions{IgnoreOrder: true}) {
 				t.Errorf("expected %v but got %v", testCase.expected, result)
 			}
 		})
@@ -902,7 +1366,7 @@ func TestUniq(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := Uniq(testCase.input)
-			if !interfaceSliceEqual(result, testCase.expected) {
+			if !IsEqualWith(result, testCase.expected, EqualOptions{NilEqualsEmpty: true}) {
 				t.Errorf("expected %v but got %v", testCase.expected, result)
 			}
 		})
@@ -953,3 +1417,39 @@ func TestZip(t *testing.T) {
 		})
 	}
 }
+
+// joinConcat is the previous implementation of Join, kept to benchmark against.
+func joinConcat(arr []interface{}, separator string) string {
+	var result string
+	for i, elem := range arr {
+		if i > 0 {
+			result += separator
+		}
+		result += fmt.Sprintf("%v", elem)
+	}
+	return result
+}
+
+func benchmarkJoinInput() []interface{} {
+	input := make([]interface{}, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	return input
+}
+
+func BenchmarkJoin(b *testing.B) {
+	input := benchmarkJoinInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Join(input, ",")
+	}
+}
+
+func BenchmarkJoinConcat(b *testing.B) {
+	input := benchmarkJoinInput()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		joinConcat(input, ",")
+	}
+}
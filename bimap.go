@@ -0,0 +1,102 @@
+package fusion
+
+import "errors"
+
+// ErrDuplicateValue is returned by BiMap.Put when the value is already mapped from another key
+// and the map rejects duplicates.
+var ErrDuplicateValue = errors.New("fusion: value is already mapped from another key")
+
+// BiMapMode selects what a BiMap does when a value is put under a new key while another key maps to it.
+type BiMapMode int
+
+const (
+	// BiMapReject keeps the existing entry and returns ErrDuplicateValue.
+	BiMapReject BiMapMode = iota
+	// BiMapOverwrite removes the existing entry of the value before storing the new one.
+	BiMapOverwrite
+)
+
+// BiMap is a one-to-one map that can be looked up by key or by value.
+// The zero value is not usable; create maps with NewBiMap.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	mode     BiMapMode
+}
+
+// NewBiMap returns an empty bidirectional map that handles duplicate values according to mode.
+func NewBiMap[K comparable, V comparable](mode BiMapMode) *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), backward: make(map[V]K), mode: mode}
+}
+
+// Delete removes key and its value and reports whether key was present.
+func (b *BiMap[K, V]) Delete(key K) bool {
+	value, ok := b.forward[key]
+	if !ok {
+		return false
+	}
+	delete(b.forward, key)
+	delete(b.backward, value)
+	return true
+}
+
+// DeleteValue removes value and its key and reports whether value was present.
+func (b *BiMap[K, V]) DeleteValue(value V) bool {
+	return b.Inverse().Delete(value)
+}
+
+// Get returns the value of key, or false if key is not present.
+func (b *BiMap[K, V]) Get(key K) (V, bool) {
+	value, ok := b.forward[key]
+	return value, ok
+}
+
+// GetKey returns the key of value, or false if value is not present.
+func (b *BiMap[K, V]) GetKey(value V) (K, bool) {
+	key, ok := b.backward[value]
+	return key, ok
+}
+
+// Inverse returns a view of the map with keys and values swapped. The view shares its storage
+// with b, so changes made through either are visible in both.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: b.backward, backward: b.forward, mode: b.mode}
+}
+
+// Keys returns the keys in no particular order.
+func (b *BiMap[K, V]) Keys() []K {
+	return Keys(b.forward)
+}
+
+// Len returns the number of entries.
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Put maps key to value, replacing the previous value of key. If another key already maps to value,
+// Put either returns ErrDuplicateValue or removes that key, depending on the mode of the map.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if existing, ok := b.backward[value]; ok && existing != key {
+		if b.mode == BiMapReject {
+			return ErrDuplicateValue
+		}
+		delete(b.forward, existing)
+	}
+
+	if previous, ok := b.forward[key]; ok {
+		delete(b.backward, previous)
+	}
+	b.forward[key] = value
+	b.backward[value] = key
+	return nil
+}
+
+// ToMap returns a copy of the map from keys to values.
+func (b *BiMap[K, V]) ToMap() map[K]V {
+	return Merge(b.forward)
+}
+
+// Values returns the values in no particular order.
+func (b *BiMap[K, V]) Values() []V {
+	return Values(b.forward)
+}
@@ -0,0 +1,110 @@
+package fusion
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBiMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		mode     BiMapMode
+		err      error
+		expected map[string]int
+	}{
+		{
+			name:     "reject",
+			mode:     BiMapReject,
+			err:      ErrDuplicateValue,
+			expected: map[string]int{"one": 1, "two": 2},
+		},
+		{
+			name:     "overwrite",
+			mode:     BiMapOverwrite,
+			expected: map[string]int{"two": 2, "uno": 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b := NewBiMap[string, int](testCase.mode)
+			_ = b.Put("one", 1)
+			_ = b.Put("two", 2)
+
+			if err := b.Put("uno", 1); !errors.Is(err, testCase.err) {
+				t.Errorf("expected %v but got %v", testCase.err, err)
+			}
+			if result := b.ToMap(); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+			if inverse := b.Inverse().ToMap(); len(inverse) != len(testCase.expected) {
+				t.Errorf("expected the inverse to stay one-to-one but got %v", inverse)
+			}
+		})
+	}
+}
+
+func TestBiMapUpdates(t *testing.T) {
+	t.Parallel()
+
+	b := NewBiMap[string, int](BiMapReject)
+	_ = b.Put("a", 1)
+
+	// Changing the value of a key releases its old value.
+	if err := b.Put("a", 2); err != nil {
+		t.Errorf("expected no error but got %v", err)
+	}
+	if _, ok := b.GetKey(1); ok {
+		t.Errorf("expected value 1 to be released")
+	}
+	if err := b.Put("b", 1); err != nil {
+		t.Errorf("expected no error but got %v", err)
+	}
+	if err := b.Put("a", 2); err != nil {
+		t.Errorf("expected putting the same entry again to succeed but got %v", err)
+	}
+
+	if value, ok := b.Get("a"); !ok || value != 2 {
+		t.Errorf("expected 2 but got %d", value)
+	}
+	if key, ok := b.GetKey(1); !ok || key != "b" {
+		t.Errorf("expected b but got %s", key)
+	}
+	if b.Len() != 2 {
+		t.Errorf("expected length 2 but got %d", b.Len())
+	}
+
+	if !b.DeleteValue(2) || b.Delete("a") {
+		t.Errorf("expected DeleteValue to remove a")
+	}
+	if expected := map[string]int{"b": 1}; !IsEqual(b.ToMap(), expected) {
+		t.Errorf("expected %v but got %v", expected, b.ToMap())
+	}
+}
+
+func TestBiMapInverse(t *testing.T) {
+	t.Parallel()
+
+	b := NewBiMap[string, int](BiMapReject)
+	inverse := b.Inverse()
+
+	_ = b.Put("a", 1)
+	if err := inverse.Put(2, "b"); err != nil {
+		t.Errorf("expected no error but got %v", err)
+	}
+	if err := inverse.Put(3, "a"); !errors.Is(err, ErrDuplicateValue) {
+		t.Errorf("expected %v but got %v", ErrDuplicateValue, err)
+	}
+
+	if value, ok := b.Get("b"); !ok || value != 2 {
+		t.Errorf("expected a write through the inverse to be visible but got %d", value)
+	}
+	if key, ok := inverse.Get(1); !ok || key != "a" {
+		t.Errorf("expected a write to be visible through the inverse but got %s", key)
+	}
+	if back := inverse.Inverse(); back.Len() != 2 || !IsEqual(back.ToMap(), b.ToMap()) {
+		t.Errorf("expected the inverse of the inverse to match the original")
+	}
+}
@@ -0,0 +1,230 @@
+package fusion
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// Hasher returns a 64-bit hash of a value. Probabilistic structures mix the result further,
+// so a hasher only needs to map different values to different hashes most of the time.
+type Hasher[T any] func(T) uint64
+
+// DefaultHasher hashes a value with 64-bit FNV-1a. Strings, byte slices, booleans and numbers,
+// including named types based on them, are hashed from their contents; other values are hashed
+// from their Go-syntax representation. The result is the same across processes and platforms.
+func DefaultHasher[T any](value T) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+
+	v := reflect.ValueOf(&value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		_, _ = h.Write([]byte(v.String()))
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			_, _ = h.Write(v.Bytes())
+		} else {
+			_, _ = fmt.Fprintf(h, "%#v", value)
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			buf[0] = 1
+		}
+		_, _ = h.Write(buf[:1])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(v.Int()))
+		_, _ = h.Write(buf[:])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		binary.LittleEndian.PutUint64(buf[:], v.Uint())
+		_, _ = h.Write(buf[:])
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == 0 {
+			// -0 and +0 are equal, so they must hash the same.
+			f = 0
+		}
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(f))
+		_, _ = h.Write(buf[:])
+	default:
+		_, _ = fmt.Fprintf(h, "%#v", value)
+	}
+	return h.Sum64()
+}
+
+// BloomFilter is a set that answers membership queries with no false negatives and a bounded rate of
+// false positives, using a fixed amount of memory. The zero value is not usable; create filters with NewBloomFilter.
+type BloomFilter[T any] struct {
+	bits   []uint64
+	size   uint64
+	hashes int
+	count  int
+	hasher Hasher[T]
+}
+
+// NewBloomFilter returns a filter sized to hold expectedItems values with the given false-positive rate.
+// A nil hasher selects DefaultHasher. It panics if expectedItems is not positive or the rate is not between 0 and 1.
+func NewBloomFilter[T any](expectedItems int, falsePositiveRate float64, hasher Hasher[T]) *BloomFilter[T] {
+	size, hashes := bloomParameters(expectedItems, falsePositiveRate)
+	return &BloomFilter[T]{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+		hasher: hasherOrDefault(hasher),
+	}
+}
+
+// Add inserts value into the filter.
+func (b *BloomFilter[T]) Add(value T) {
+	h1, h2 := splitHash(b.hasher(value))
+	for i := 0; i < b.hashes; i++ {
+		bit := bloomIndex(h1, h2, i, b.size)
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+	b.count++
+}
+
+// Clear removes all values from the filter.
+func (b *BloomFilter[T]) Clear() {
+	for i := range b.bits {
+		b.bits[i] = 0
+	}
+	b.count = 0
+}
+
+// Contains reports whether value may have been added. A false result is always correct,
+// while a true result is wrong with roughly the configured false-positive rate.
+func (b *BloomFilter[T]) Contains(value T) bool {
+	h1, h2 := splitHash(b.hasher(value))
+	for i := 0; i < b.hashes; i++ {
+		bit := bloomIndex(h1, h2, i, b.size)
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of times Add has been called since the filter was created or cleared.
+func (b *BloomFilter[T]) Count() int {
+	return b.count
+}
+
+// FalsePositiveRate estimates the current false-positive rate from the number of added values.
+func (b *BloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(b.hashes)*float64(b.count)/float64(b.size)), float64(b.hashes))
+}
+
+// CountingBloomFilter is a Bloom filter that keeps a small counter instead of a bit per slot,
+// so values can be removed. Counters saturate at 255 and are then never decremented.
+// The zero value is not usable; create filters with NewCountingBloomFilter.
+type CountingBloomFilter[T any] struct {
+	counters []uint8
+	hashes   int
+	hasher   Hasher[T]
+}
+
+// NewCountingBloomFilter returns a counting filter sized like NewBloomFilter. It uses a byte per slot,
+// eight times the memory of a BloomFilter.
+func NewCountingBloomFilter[T any](expectedItems int, falsePositiveRate float64, hasher Hasher[T]) *CountingBloomFilter[T] {
+	size, hashes := bloomParameters(expectedItems, falsePositiveRate)
+	return &CountingBloomFilter[T]{
+		counters: make([]uint8, size),
+		hashes:   hashes,
+		hasher:   hasherOrDefault(hasher),
+	}
+}
+
+// Add inserts value into the filter.
+func (c *CountingBloomFilter[T]) Add(value T) {
+	h1, h2 := splitHash(c.hasher(value))
+	for i := 0; i < c.hashes; i++ {
+		slot := bloomIndex(h1, h2, i, uint64(len(c.counters)))
+		if c.counters[slot] < math.MaxUint8 {
+			c.counters[slot]++
+		}
+	}
+}
+
+// Clear removes all values from the filter.
+func (c *CountingBloomFilter[T]) Clear() {
+	for i := range c.counters {
+		c.counters[i] = 0
+	}
+}
+
+// Contains reports whether value may have been added and not removed since.
+func (c *CountingBloomFilter[T]) Contains(value T) bool {
+	h1, h2 := splitHash(c.hasher(value))
+	for i := 0; i < c.hashes; i++ {
+		if c.counters[bloomIndex(h1, h2, i, uint64(len(c.counters)))] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove deletes one occurrence of value and reports whether it may have been present.
+// Removing a value that was never added can remove other values, so only remove added values.
+func (c *CountingBloomFilter[T]) Remove(value T) bool {
+	if !c.Contains(value) {
+		return false
+	}
+	h1, h2 := splitHash(c.hasher(value))
+	for i := 0; i < c.hashes; i++ {
+		slot := bloomIndex(h1, h2, i, uint64(len(c.counters)))
+		if c.counters[slot] < math.MaxUint8 {
+			c.counters[slot]--
+		}
+	}
+	return true
+}
+
+// bloomIndex returns the i-th slot of a value using double hashing.
+func bloomIndex(h1, h2 uint64, i int, size uint64) uint64 {
+	return (h1 + uint64(i)*h2) % size
+}
+
+// bloomParameters returns the optimal number of slots and hash functions for a filter.
+func bloomParameters(expectedItems int, falsePositiveRate float64) (uint64, int) {
+	if expectedItems <= 0 {
+		panic("fusion: expected items must be positive")
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic("fusion: false-positive rate must be between 0 and 1")
+	}
+
+	n := float64(expectedItems)
+	size := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	hashes := int(math.Round(size / n * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return uint64(size), hashes
+}
+
+func hasherOrDefault[T any](hasher Hasher[T]) Hasher[T] {
+	if hasher == nil {
+		return DefaultHasher[T]
+	}
+	return hasher
+}
+
+// mixHash scrambles the bits of a hash with the SplitMix64 finalizer, so weak hashers still spread evenly.
+func mixHash(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// splitHash derives the two hashes used for double hashing from a single hash.
+func splitHash(h uint64) (uint64, uint64) {
+	h1 := mixHash(h)
+	h2 := mixHash(h1) | 1
+	return h1, h2
+}
@@ -0,0 +1,182 @@
+package fusion
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestDefaultHasher(t *testing.T) {
+	t.Parallel()
+
+	type name string
+	type point struct{ X, Y int }
+
+	if DefaultHasher("abc") != DefaultHasher("abc") || DefaultHasher("abc") == DefaultHasher("abd") {
+		t.Errorf("expected string hashes to depend only on the contents")
+	}
+	if DefaultHasher(name("abc")) != DefaultHasher("abc") {
+		t.Errorf("expected a named string type to hash like its contents")
+	}
+	if DefaultHasher([]byte("abc")) != DefaultHasher("abc") {
+		t.Errorf("expected a byte slice to hash like the equivalent string")
+	}
+	if DefaultHasher(int8(1)) != DefaultHasher(int64(1)) || DefaultHasher(1) == DefaultHasher(2) {
+		t.Errorf("expected integer hashes to depend only on the value")
+	}
+	if DefaultHasher(point{1, 2}) != DefaultHasher(point{1, 2}) || DefaultHasher(point{1, 2}) == DefaultHasher(point{2, 1}) {
+		t.Errorf("expected struct hashes to depend on the fields")
+	}
+	if DefaultHasher(math.Copysign(0, -1)) != DefaultHasher(0.0) || DefaultHasher(float32(math.Copysign(0, -1))) != DefaultHasher(float32(0)) {
+		t.Errorf("expected -0 and +0 to hash the same")
+	}
+	// FNV-1a of the empty input is its offset basis.
+	if hash := DefaultHasher(""); hash != 14695981039346656037 {
+		t.Errorf("expected 14695981039346656037 but got %d", hash)
+	}
+}
+
+func TestBloomFilterNegativeZero(t *testing.T) {
+	t.Parallel()
+
+	filter := NewBloomFilter[float64](100, 0.01, nil)
+	filter.Add(math.Copysign(0, -1))
+	if !filter.Contains(0) {
+		t.Errorf("expected the filter to contain +0 after adding -0")
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		items int
+		rate  float64
+	}{
+		{name: "one percent", items: 1000, rate: 0.01},
+		{name: "tenth of a percent", items: 5000, rate: 0.001},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewBloomFilter[string](testCase.items, testCase.rate, nil)
+			for i := 0; i < testCase.items; i++ {
+				filter.Add("member-" + strconv.Itoa(i))
+			}
+
+			for i := 0; i < testCase.items; i++ {
+				if !filter.Contains("member-" + strconv.Itoa(i)) {
+					t.Fatalf("expected no false negatives but member-%d is missing", i)
+				}
+			}
+
+			falsePositives := 0
+			const probes = 20000
+			for i := 0; i < probes; i++ {
+				if filter.Contains("other-" + strconv.Itoa(i)) {
+					falsePositives++
+				}
+			}
+			if rate := float64(falsePositives) / probes; rate > 2*testCase.rate {
+				t.Errorf("expected a false-positive rate near %v but got %v", testCase.rate, rate)
+			}
+			if estimate := filter.FalsePositiveRate(); estimate > 1.5*testCase.rate {
+				t.Errorf("expected an estimated rate near %v but got %v", testCase.rate, estimate)
+			}
+			if filter.Count() != testCase.items {
+				t.Errorf("expected count %d but got %d", testCase.items, filter.Count())
+			}
+
+			filter.Clear()
+			if filter.Contains("member-0") || filter.Count() != 0 {
+				t.Errorf("expected an empty filter after Clear")
+			}
+		})
+	}
+}
+
+func TestBloomFilterHasher(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	// Hash users by ID only, so the name does not affect membership.
+	filter := NewBloomFilter(100, 0.01, func(u user) uint64 { return uint64(u.ID) })
+	filter.Add(user{ID: 7, Name: "ann"})
+	if !filter.Contains(user{ID: 7, Name: "bob"}) {
+		t.Errorf("expected the custom hasher to be used")
+	}
+}
+
+func TestBloomFilterPanics(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		items int
+		rate  float64
+	}{
+		{name: "no items", items: 0, rate: 0.01},
+		{name: "zero rate", items: 10, rate: 0},
+		{name: "rate of one", items: 10, rate: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected NewBloomFilter to panic")
+				}
+			}()
+			NewBloomFilter[int](testCase.items, testCase.rate, nil)
+		})
+	}
+}
+
+func TestCountingBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := NewCountingBloomFilter[int](1000, 0.01, nil)
+	for i := 0; i < 1000; i++ {
+		filter.Add(i)
+	}
+	for i := 0; i < 500; i++ {
+		if !filter.Remove(i) {
+			t.Fatalf("expected to remove %d", i)
+		}
+	}
+
+	for i := 500; i < 1000; i++ {
+		if !filter.Contains(i) {
+			t.Fatalf("expected no false negatives but %d is missing", i)
+		}
+	}
+	remaining := 0
+	for i := 0; i < 500; i++ {
+		if filter.Contains(i) {
+			remaining++
+		}
+	}
+	if remaining > 20 {
+		t.Errorf("expected removed values to be gone but %d remain", remaining)
+	}
+
+	filter.Add(5000)
+	filter.Add(5000)
+	filter.Remove(5000)
+	if !filter.Contains(5000) {
+		t.Errorf("expected a value added twice to survive one removal")
+	}
+
+	filter.Clear()
+	if filter.Contains(700) || filter.Remove(700) {
+		t.Errorf("expected an empty filter after Clear")
+	}
+}
@@ -0,0 +1,173 @@
+package fusion
+
+import (
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// Cloner is implemented by types that know how to deep clone themselves.
+// CloneDeep calls Clone instead of copying the value by reflection whenever a value
+// has a Clone method with no arguments that returns its own type.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// CloneDeep returns a deep copy of v. Slices, maps, pointers, interfaces, arrays and structs,
+// including their unexported fields, are copied recursively. Values reachable through several
+// references, including cyclic ones, are copied once and the copies share the same references.
+// Functions, channels and unsafe pointers are copied shallowly, and time.Time is treated as a value.
+func CloneDeep[T any](v T) T {
+	c := deepCloner{visited: make(map[visitKey]reflect.Value)}
+	var result T
+	reflect.ValueOf(&result).Elem().Set(c.clone(reflect.ValueOf(&v).Elem()))
+	return result
+}
+
+// CloneMap returns a shallow copy of a map. A nil map stays nil.
+func CloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// CloneSlice returns a shallow copy of a slice. A nil slice stays nil.
+func CloneSlice[T any](arr []T) []T {
+	if arr == nil {
+		return nil
+	}
+	result := make([]T, len(arr))
+	copy(result, arr)
+	return result
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// visitKey identifies a reference that has already been cloned.
+// Slices also record their length, so that distinct sub-slices of one array are told apart.
+type visitKey struct {
+	ptr    uintptr
+	length int
+	typ    reflect.Type
+}
+
+type deepCloner struct {
+	visited map[visitKey]reflect.Value
+}
+
+func (c *deepCloner) clone(v reflect.Value) reflect.Value {
+	if cloned, ok := callClone(v); ok {
+		return cloned
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+		if cloned, ok := c.visited[key]; ok {
+			return cloned
+		}
+		cloned := reflect.New(v.Type().Elem())
+		c.visited[key] = cloned
+		cloned.Elem().Set(c.clone(v.Elem()))
+		return cloned
+
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+		if cloned, ok := c.visited[key]; ok {
+			return cloned
+		}
+		cloned := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.visited[key] = cloned
+		iter := v.MapRange()
+		for iter.Next() {
+			cloned.SetMapIndex(c.clone(iter.Key()), c.clone(iter.Value()))
+		}
+		return cloned
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := visitKey{ptr: v.Pointer(), length: v.Len(), typ: v.Type()}
+		if cloned, ok := c.visited[key]; ok {
+			return cloned
+		}
+		cloned := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		c.visited[key] = cloned
+		for i := 0; i < v.Len(); i++ {
+			cloned.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return cloned
+
+	case reflect.Array:
+		cloned := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			cloned.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return cloned
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v
+		}
+		if !v.CanAddr() {
+			addressable := reflect.New(v.Type()).Elem()
+			addressable.Set(v)
+			v = addressable
+		}
+		cloned := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			accessible(cloned.Field(i)).Set(c.clone(accessible(v.Field(i))))
+		}
+		return cloned
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		cloned := reflect.New(v.Type()).Elem()
+		cloned.Set(c.clone(v.Elem()))
+		return cloned
+
+	default:
+		return v
+	}
+}
+
+// accessible returns a settable view of an addressable value, including unexported struct fields.
+func accessible(v reflect.Value) reflect.Value {
+	if v.CanSet() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// callClone invokes the Clone method of v if it implements Cloner for its own type.
+func callClone(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Interface || !v.CanInterface() {
+		return reflect.Value{}, false
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return reflect.Value{}, false
+	}
+	method := v.MethodByName("Clone")
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 || methodType.Out(0) != v.Type() {
+		return reflect.Value{}, false
+	}
+	return method.Call(nil)[0], true
+}
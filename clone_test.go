@@ -0,0 +1,186 @@
+package fusion
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type cloneNode struct {
+	Value int
+	Next  *cloneNode
+}
+
+type cloneRecord struct {
+	Name    string
+	Tags    []string
+	Meta    map[string]interface{}
+	Parent  *cloneRecord
+	Created time.Time
+	secret  []int
+}
+
+type cloneCounter struct {
+	count  int
+	clones *int
+}
+
+func (c cloneCounter) Clone() cloneCounter {
+	*c.clones++
+	return cloneCounter{count: c.count * 10, clones: c.clones}
+}
+
+func TestCloneDeep(t *testing.T) {
+	t.Parallel()
+
+	original := map[string]interface{}{
+		"list":   []interface{}{1, "two", map[string]interface{}{"three": 3}},
+		"nested": map[string]interface{}{"a": []int{1, 2}},
+		"nil":    nil,
+	}
+
+	cloned := CloneDeep(original)
+	if !reflect.DeepEqual(cloned, original) {
+		t.Fatalf("expected %v but got %v", original, cloned)
+	}
+
+	cloned["nested"].(map[string]interface{})["a"].([]int)[0] = 100
+	cloned["list"].([]interface{})[2].(map[string]interface{})["three"] = 30
+	Reverse(cloned["list"].([]interface{}))
+
+	if original["nested"].(map[string]interface{})["a"].([]int)[0] != 1 {
+		t.Errorf("expected the nested slice of the original to be untouched")
+	}
+	if original["list"].([]interface{})[2].(map[string]interface{})["three"] != 3 {
+		t.Errorf("expected the nested map of the original to be untouched")
+	}
+	if original["list"].([]interface{})[0] != 1 {
+		t.Errorf("expected the original list order to be untouched")
+	}
+}
+
+func TestCloneDeepStruct(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	parent := &cloneRecord{Name: "parent"}
+	original := cloneRecord{
+		Name:    "child",
+		Tags:    []string{"a", "b"},
+		Meta:    map[string]interface{}{"k": []string{"v"}},
+		Parent:  parent,
+		Created: created,
+		secret:  []int{1, 2, 3},
+	}
+
+	cloned := CloneDeep(original)
+	if !reflect.DeepEqual(cloned, original) {
+		t.Fatalf("expected %+v but got %+v", original, cloned)
+	}
+
+	cloned.Tags[0] = "x"
+	cloned.secret[0] = 100
+	cloned.Parent.Name = "changed"
+	cloned.Meta["k"].([]string)[0] = "changed"
+
+	if original.Tags[0] != "a" || original.secret[0] != 1 {
+		t.Errorf("expected slices of the original to be untouched, including unexported fields")
+	}
+	if parent.Name != "parent" {
+		t.Errorf("expected the pointed-to struct to be copied")
+	}
+	if original.Meta["k"].([]string)[0] != "v" {
+		t.Errorf("expected the map values to be copied")
+	}
+	if cloned.Created.Location() != time.UTC {
+		t.Errorf("expected time.Time to keep its location")
+	}
+}
+
+func TestCloneDeepReferences(t *testing.T) {
+	t.Parallel()
+
+	cycle := &cloneNode{Value: 1}
+	cycle.Next = &cloneNode{Value: 2, Next: cycle}
+
+	cloned := CloneDeep(cycle)
+	if cloned == cycle || cloned.Next == cycle.Next {
+		t.Fatalf("expected new nodes to be allocated")
+	}
+	if cloned.Next.Next != cloned {
+		t.Errorf("expected the cycle to be preserved in the copy")
+	}
+
+	shared := &cloneNode{Value: 7}
+	pair := []*cloneNode{shared, shared}
+	clonedPair := CloneDeep(pair)
+	if clonedPair[0] != clonedPair[1] || clonedPair[0] == shared {
+		t.Errorf("expected shared references to stay shared in the copy")
+	}
+
+	selfReferencing := map[string]interface{}{}
+	selfReferencing["self"] = selfReferencing
+	clonedMap := CloneDeep(selfReferencing)
+	if reflect.ValueOf(clonedMap["self"]).Pointer() != reflect.ValueOf(clonedMap).Pointer() {
+		t.Errorf("expected the self-referencing map to reference its copy")
+	}
+}
+
+func TestCloneDeepCloner(t *testing.T) {
+	t.Parallel()
+
+	clones := 0
+	original := []cloneCounter{{count: 1, clones: &clones}, {count: 2, clones: &clones}}
+
+	cloned := CloneDeep(original)
+	if clones != 2 {
+		t.Errorf("expected Clone to be called twice but got %d", clones)
+	}
+	if cloned[0].count != 10 || cloned[1].count != 20 {
+		t.Errorf("expected the Clone results to be used but got %+v", cloned)
+	}
+
+	var _ Cloner[cloneCounter] = cloneCounter{}
+}
+
+func TestCloneDeepNil(t *testing.T) {
+	t.Parallel()
+
+	var nilMap map[string]int
+	if result := CloneDeep(nilMap); result != nil {
+		t.Errorf("expected nil but got %v", result)
+	}
+
+	var nilInterface interface{}
+	if result := CloneDeep(nilInterface); result != nil {
+		t.Errorf("expected nil but got %v", result)
+	}
+
+	if result := CloneDeep(42); result != 42 {
+		t.Errorf("expected 42 but got %v", result)
+	}
+}
+
+func TestCloneSliceMap(t *testing.T) {
+	t.Parallel()
+
+	arr := []int{1, 2, 3}
+	clonedArr := CloneSlice(arr)
+	clonedArr[0] = 100
+	if arr[0] != 1 {
+		t.Errorf("expected the original slice to be untouched")
+	}
+	if CloneSlice[int](nil) != nil {
+		t.Errorf("expected a nil slice to stay nil")
+	}
+
+	m := map[string]int{"a": 1}
+	clonedMap := CloneMap(m)
+	clonedMap["a"] = 100
+	if m["a"] != 1 {
+		t.Errorf("expected the original map to be untouched")
+	}
+	if CloneMap[string, int](nil) != nil {
+		t.Errorf("expected a nil map to stay nil")
+	}
+}
@@ -0,0 +1,307 @@
+package fusion
+
+import "errors"
+
+// ErrBufferFull is returned by RingBuffer.Push when the buffer is full and rejects new elements.
+var ErrBufferFull = errors.New("fusion: ring buffer is full")
+
+// minDequeCapacity is the capacity a Deque allocates for its first element.
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue backed by a ring buffer that grows and shrinks as needed.
+// Pushing and popping at either end are amortized O(1). The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+}
+
+// NewDeque returns an empty deque with room for capacity elements before it grows.
+func NewDeque[T any](capacity int) *Deque[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &Deque[T]{buf: make([]T, capacity)}
+}
+
+// DequeFromSlice returns a deque holding a copy of the elements of arr, with arr[0] at the front.
+func DequeFromSlice[T any](arr []T) *Deque[T] {
+	return &Deque[T]{buf: append([]T(nil), arr...), length: len(arr)}
+}
+
+// At returns the element at index i, counted from the front. It panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	return d.buf[d.index(i)]
+}
+
+// Back returns the element at the back without removing it, or false if the deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.physical(d.length-1)], true
+}
+
+// Clear removes all elements and releases the underlying buffer.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// Front returns the element at the front without removing it, or false if the deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+// PopBack removes and returns the element at the back, or returns false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+	i := d.physical(d.length - 1)
+	value := d.buf[i]
+	var zero T
+	d.buf[i] = zero
+	d.length--
+	d.shrink()
+	return value, true
+}
+
+// PopFront removes and returns the element at the front, or returns false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+	value := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.physical(1)
+	d.length--
+	d.shrink()
+	return value, true
+}
+
+// PushBack adds value at the back of the deque.
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.physical(d.length)] = value
+	d.length++
+}
+
+// PushFront adds value at the front of the deque.
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = d.physical(len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.length++
+}
+
+// Rotate moves the last n elements to the front, so Rotate(1) on [1 2 3] gives [3 1 2].
+// A negative n moves the first -n elements to the back.
+func (d *Deque[T]) Rotate(n int) {
+	if d.length <= 1 {
+		return
+	}
+	n %= d.length
+	if n < 0 {
+		n += d.length
+	}
+	if n == 0 {
+		return
+	}
+
+	if d.length == len(d.buf) {
+		d.head = d.physical(d.length - n)
+		return
+	}
+	if n <= d.length/2 {
+		for i := 0; i < n; i++ {
+			value, _ := d.PopBack()
+			d.PushFront(value)
+		}
+		return
+	}
+	for i := 0; i < d.length-n; i++ {
+		value, _ := d.PopFront()
+		d.PushBack(value)
+	}
+}
+
+// Set replaces the element at index i, counted from the front. It panics if i is out of range.
+func (d *Deque[T]) Set(i int, value T) {
+	d.buf[d.index(i)] = value
+}
+
+// ToSlice returns a copy of the elements from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	result := make([]T, d.length)
+	ringCopy(result, d.buf, d.head, d.length)
+	return result
+}
+
+// grow makes room for one more element, doubling the buffer when it is full.
+func (d *Deque[T]) grow() {
+	if d.length < len(d.buf) {
+		return
+	}
+	capacity := 2 * len(d.buf)
+	if capacity < minDequeCapacity {
+		capacity = minDequeCapacity
+	}
+	d.resize(capacity)
+}
+
+// index returns the buffer index of the element at index i, panicking if i is out of range.
+func (d *Deque[T]) index(i int) int {
+	if i < 0 || i >= d.length {
+		panic("fusion: deque index out of range")
+	}
+	return d.physical(i)
+}
+
+// physical returns the buffer index that is i positions after the head.
+func (d *Deque[T]) physical(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	ringCopy(buf, d.buf, d.head, d.length)
+	d.buf = buf
+	d.head = 0
+}
+
+// shrink halves the buffer once it is a quarter full, so a deque that drained does not hold on to memory.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCapacity && d.length <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// RingBufferMode selects what a full RingBuffer does with new elements.
+type RingBufferMode int
+
+const (
+	// RingBufferOverwrite drops the oldest element to make room for a new one.
+	RingBufferOverwrite RingBufferMode = iota
+	// RingBufferReject keeps the buffer unchanged and returns ErrBufferFull.
+	RingBufferReject
+)
+
+// RingBuffer is a fixed-capacity FIFO queue. The zero value is not usable; create buffers with NewRingBuffer.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	length int
+	mode   RingBufferMode
+}
+
+// NewRingBuffer returns an empty buffer holding at most capacity elements. It panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int, mode RingBufferMode) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("fusion: ring buffer capacity must be positive")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), mode: mode}
+}
+
+// At returns the element at index i, where 0 is the oldest. It panics if i is out of range.
+func (r *RingBuffer[T]) At(i int) T {
+	if i < 0 || i >= r.length {
+		panic("fusion: ring buffer index out of range")
+	}
+	return r.buf[(r.head+i)%len(r.buf)]
+}
+
+// Cap returns the maximum number of elements in the buffer.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Clear removes all elements.
+func (r *RingBuffer[T]) Clear() {
+	var zero T
+	for i := range r.buf {
+		r.buf[i] = zero
+	}
+	r.head, r.length = 0, 0
+}
+
+// Full reports whether the buffer holds Cap elements.
+func (r *RingBuffer[T]) Full() bool {
+	return r.length == len(r.buf)
+}
+
+// Len returns the number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.length
+}
+
+// Peek returns the oldest element without removing it, or false if the buffer is empty.
+func (r *RingBuffer[T]) Peek() (T, bool) {
+	if r.length == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// Pop removes and returns the oldest element, or returns false if the buffer is empty.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	if r.length == 0 {
+		var zero T
+		return zero, false
+	}
+	value := r.buf[r.head]
+	var zero T
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.length--
+	return value, true
+}
+
+// Push adds value as the newest element. When the buffer is full it either drops the oldest element
+// or returns ErrBufferFull, depending on the mode of the buffer.
+func (r *RingBuffer[T]) Push(value T) error {
+	if r.Full() {
+		if r.mode == RingBufferReject {
+			return ErrBufferFull
+		}
+		r.buf[r.head] = value
+		r.head = (r.head + 1) % len(r.buf)
+		return nil
+	}
+	r.buf[(r.head+r.length)%len(r.buf)] = value
+	r.length++
+	return nil
+}
+
+// ToSlice returns a copy of the elements from oldest to newest.
+func (r *RingBuffer[T]) ToSlice() []T {
+	result := make([]T, r.length)
+	ringCopy(result, r.buf, r.head, r.length)
+	return result
+}
+
+// ringCopy copies length elements of buf starting at head into dst, wrapping around the end of buf.
+func ringCopy[T any](dst, buf []T, head, length int) {
+	if length == 0 {
+		return
+	}
+	end := head + length
+	if end > len(buf) {
+		end = len(buf)
+	}
+	n := copy(dst, buf[head:end])
+	copy(dst[n:], buf[:length-n])
+}
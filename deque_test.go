@@ -0,0 +1,252 @@
+package fusion
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	t.Parallel()
+
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("expected PopFront on an empty deque to return false")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("expected Back on an empty deque to return false")
+	}
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	if expected := []int{0, 1, 2, 3}; !IsEqual(d.ToSlice(), expected) {
+		t.Errorf("expected %v but got %v", expected, d.ToSlice())
+	}
+
+	d.Set(1, 10)
+	if value := d.At(1); value != 10 {
+		t.Errorf("expected 10 but got %d", value)
+	}
+	if front, _ := d.Front(); front != 0 {
+		t.Errorf("expected front 0 but got %d", front)
+	}
+	if back, _ := d.Back(); back != 3 {
+		t.Errorf("expected back 3 but got %d", back)
+	}
+	if value, ok := d.PopBack(); !ok || value != 3 {
+		t.Errorf("expected to pop 3 but got %d", value)
+	}
+	if value, ok := d.PopFront(); !ok || value != 0 {
+		t.Errorf("expected to pop 0 but got %d", value)
+	}
+	if d.Len() != 2 {
+		t.Errorf("expected length 2 but got %d", d.Len())
+	}
+
+	d.Clear()
+	if d.Len() != 0 || len(d.ToSlice()) != 0 {
+		t.Errorf("expected an empty deque but got %v", d.ToSlice())
+	}
+
+	if chunks := Chunk(DequeFromSlice([]int{1, 2, 3}).ToSlice(), 2); !IsEqual(chunks, [][]int{{1, 2}, {3}}) {
+		t.Errorf("expected [[1 2] [3]] but got %v", chunks)
+	}
+}
+
+func TestDequeAtPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected At to panic for an index out of range")
+		}
+	}()
+	d := DequeFromSlice([]int{1, 2})
+	d.At(2)
+}
+
+func TestDequeRotate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{
+			name:     "right by one",
+			n:        1,
+			expected: []int{5, 1, 2, 3, 4},
+		},
+		{
+			name:     "right by three",
+			n:        3,
+			expected: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:     "left by one",
+			n:        -1,
+			expected: []int{2, 3, 4, 5, 1},
+		},
+		{
+			name:     "full turn",
+			n:        10,
+			expected: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// A full buffer rotates by moving the head, a partly filled one by moving elements.
+			full := DequeFromSlice([]int{1, 2, 3, 4, 5})
+			partial := NewDeque[int](16)
+			for i := 1; i <= 5; i++ {
+				partial.PushBack(i)
+			}
+
+			for _, d := range []*Deque[int]{full, partial} {
+				d.Rotate(testCase.n)
+				if result := d.ToSlice(); !IsEqual(result, testCase.expected) {
+					t.Errorf("expected %v but got %v", testCase.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestDequeRandom(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	var d Deque[int]
+	var reference []int
+
+	for step := 0; step < 5000; step++ {
+		switch rng.Intn(5) {
+		case 0:
+			d.PushBack(step)
+			reference = append(reference, step)
+		case 1:
+			d.PushFront(step)
+			reference = append([]int{step}, reference...)
+		case 2:
+			value, ok := d.PopBack()
+			if ok != (len(reference) > 0) || (ok && value != reference[len(reference)-1]) {
+				t.Fatalf("step %d: unexpected PopBack result %d", step, value)
+			}
+			if ok {
+				reference = reference[:len(reference)-1]
+			}
+		case 3:
+			value, ok := d.PopFront()
+			if ok != (len(reference) > 0) || (ok && value != reference[0]) {
+				t.Fatalf("step %d: unexpected PopFront result %d", step, value)
+			}
+			if ok {
+				reference = reference[1:]
+			}
+		case 4:
+			n := rng.Intn(7) - 3
+			d.Rotate(n)
+			if len(reference) > 0 {
+				n = ((n % len(reference)) + len(reference)) % len(reference)
+				reference = append(append([]int(nil), reference[len(reference)-n:]...), reference[:len(reference)-n]...)
+			}
+		}
+		if !IsEqualWith(d.ToSlice(), reference, EqualOptions{NilEqualsEmpty: true}) {
+			t.Fatalf("step %d: expected %v but got %v", step, reference, d.ToSlice())
+		}
+	}
+}
+
+func TestDequeReleasesMemory(t *testing.T) {
+	t.Parallel()
+
+	d := NewDeque[*int](0)
+	for i := 0; i < 1000; i++ {
+		value := i
+		d.PushBack(&value)
+	}
+	for i := 0; i < 999; i++ {
+		d.PopFront()
+	}
+
+	if len(d.buf) > 4*minDequeCapacity {
+		t.Errorf("expected the buffer to shrink but its capacity is %d", len(d.buf))
+	}
+	for i, value := range d.buf {
+		if value != nil && i != d.head {
+			t.Errorf("expected popped slot %d to be cleared", i)
+		}
+	}
+}
+
+func TestRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		mode     RingBufferMode
+		expected []int
+		errs     int
+	}{
+		{
+			name:     "overwrite",
+			mode:     RingBufferOverwrite,
+			expected: []int{3, 4, 5},
+		},
+		{
+			name:     "reject",
+			mode:     RingBufferReject,
+			expected: []int{1, 2, 3},
+			errs:     2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := NewRingBuffer[int](3, testCase.mode)
+			errs := 0
+			for i := 1; i <= 5; i++ {
+				if err := r.Push(i); err != nil {
+					if !errors.Is(err, ErrBufferFull) {
+						t.Errorf("expected %v but got %v", ErrBufferFull, err)
+					}
+					errs++
+				}
+			}
+
+			if errs != testCase.errs {
+				t.Errorf("expected %d errors but got %d", testCase.errs, errs)
+			}
+			if !r.Full() || r.Len() != 3 || r.Cap() != 3 {
+				t.Errorf("expected a full buffer of 3 but got length %d and capacity %d", r.Len(), r.Cap())
+			}
+			if result := r.ToSlice(); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+			if value := r.At(0); value != testCase.expected[0] {
+				t.Errorf("expected %d but got %d", testCase.expected[0], value)
+			}
+			if value, ok := r.Peek(); !ok || value != testCase.expected[0] {
+				t.Errorf("expected %d but got %d", testCase.expected[0], value)
+			}
+			if value, ok := r.Pop(); !ok || value != testCase.expected[0] {
+				t.Errorf("expected %d but got %d", testCase.expected[0], value)
+			}
+			if err := r.Push(6); err != nil {
+				t.Errorf("expected no error but got %v", err)
+			}
+			if expected := append(testCase.expected[1:], 6); !IsEqual(r.ToSlice(), expected) {
+				t.Errorf("expected %v but got %v", expected, r.ToSlice())
+			}
+
+			r.Clear()
+			if _, ok := r.Pop(); ok || r.Len() != 0 {
+				t.Errorf("expected an empty buffer after Clear")
+			}
+		})
+	}
+}
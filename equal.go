@@ -0,0 +1,343 @@
+package fusion
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EqualOptions configures IsEqualWith and DiffWith.
+type EqualOptions struct {
+	// IgnoreOrder compares slices and arrays as multisets, ignoring the order of their elements.
+	IgnoreOrder bool
+	// NilEqualsEmpty treats nil slices and maps as equal to empty ones,
+	// and a nil interface as equal to an empty slice or map.
+	NilEqualsEmpty bool
+	// FloatTolerance is the largest absolute difference at which two floats are still equal.
+	FloatTolerance float64
+	// IgnoreFields lists struct fields that are not compared, either by field name,
+	// such as "UpdatedAt", or by path from the compared value, such as "User.UpdatedAt".
+	IgnoreFields []string
+}
+
+// Diff returns a human-readable description of the differences between a and b,
+// with one line per mismatch prefixed by its path, or an empty string if they are equal.
+func Diff(a, b interface{}) string {
+	return DiffWith(a, b, EqualOptions{})
+}
+
+// DiffWith is like Diff, but compares values as configured by opts.
+func DiffWith(a, b interface{}, opts EqualOptions) string {
+	c := newEqualComparer(opts, false)
+	c.compare("", reflect.ValueOf(a), reflect.ValueOf(b))
+	return strings.Join(c.diffs, "\n")
+}
+
+// IsEqual performs a deep comparison between two values to determine if they are equivalent.
+// Unlike reflect.DeepEqual, NaN is equal to NaN, and struct types with an Equal method,
+// such as time.Time, are compared with that method.
+func IsEqual(a, b interface{}) bool {
+	return IsEqualWith(a, b, EqualOptions{})
+}
+
+// IsEqualWith is like IsEqual, but compares values as configured by opts.
+func IsEqualWith(a, b interface{}, opts EqualOptions) bool {
+	return newEqualComparer(opts, true).compare("", reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+type visitPair struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+type equalComparer struct {
+	opts    EqualOptions
+	ignored map[string]struct{}
+	silent  bool
+	diffs   []string
+	visited map[visitPair]struct{}
+}
+
+func newEqualComparer(opts EqualOptions, silent bool) *equalComparer {
+	ignored := make(map[string]struct{}, len(opts.IgnoreFields))
+	for _, field := range opts.IgnoreFields {
+		ignored[field] = struct{}{}
+	}
+	return &equalComparer{opts: opts, ignored: ignored, silent: silent, visited: make(map[visitPair]struct{})}
+}
+
+func (c *equalComparer) report(path, format string, args ...interface{}) bool {
+	if !c.silent {
+		if path == "" {
+			path = "(root)"
+		}
+		c.diffs = append(c.diffs, path+": "+fmt.Sprintf(format, args...))
+	}
+	return false
+}
+
+// equal compares two values without reporting differences. It tracks visited references separately,
+// so that a failed trial comparison does not mark references as equal for the caller.
+func (c *equalComparer) equal(a, b reflect.Value) bool {
+	silent := &equalComparer{opts: c.opts, ignored: c.ignored, silent: true, visited: make(map[visitPair]struct{})}
+	return silent.compare("", a, b)
+}
+
+func (c *equalComparer) compare(path string, a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() == b.IsValid() {
+			return true
+		}
+		valid := a
+		if !a.IsValid() {
+			valid = b
+		}
+		if c.opts.NilEqualsEmpty && isEmptyCollection(valid) {
+			return true
+		}
+		return c.report(path, "%s != %s", formatDiffValue(a), formatDiffValue(b))
+	}
+	if a.Type() != b.Type() {
+		return c.report(path, "type %s != %s", a.Type(), b.Type())
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if a.Kind() == reflect.Pointer || !c.opts.NilEqualsEmpty {
+			if a.IsNil() || b.IsNil() {
+				if a.IsNil() && b.IsNil() {
+					return true
+				}
+				return c.report(path, "%s != %s", formatDiffValue(a), formatDiffValue(b))
+			}
+		}
+		if a.Pointer() == b.Pointer() && (a.Kind() != reflect.Slice || a.Len() == b.Len()) {
+			return true
+		}
+		pair := visitPair{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if _, ok := c.visited[pair]; ok {
+			return true
+		}
+		c.visited[pair] = struct{}{}
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		if a.Bool() != b.Bool() {
+			return c.report(path, "%v != %v", a.Bool(), b.Bool())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() != b.Int() {
+			return c.report(path, "%d != %d", a.Int(), b.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.Uint() != b.Uint() {
+			return c.report(path, "%d != %d", a.Uint(), b.Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		if !c.floatEqual(a.Float(), b.Float()) {
+			return c.report(path, "%v != %v", a.Float(), b.Float())
+		}
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		if !c.floatEqual(real(x), real(y)) || !c.floatEqual(imag(x), imag(y)) {
+			return c.report(path, "%v != %v", x, y)
+		}
+	case reflect.String:
+		if a.String() != b.String() {
+			return c.report(path, "%q != %q", a.String(), b.String())
+		}
+	case reflect.Pointer:
+		return c.compare(path, a.Elem(), b.Elem())
+	case reflect.Interface:
+		return c.compare(path, a.Elem(), b.Elem())
+	case reflect.Slice, reflect.Array:
+		if c.opts.IgnoreOrder {
+			return c.compareUnordered(path, a, b)
+		}
+		return c.compareOrdered(path, a, b)
+	case reflect.Map:
+		return c.compareMaps(path, a, b)
+	case reflect.Struct:
+		return c.compareStructs(path, a, b)
+	case reflect.Func:
+		if !a.IsNil() || !b.IsNil() {
+			return c.report(path, "functions are only equal if both are nil")
+		}
+	default:
+		if a.Interface() != b.Interface() {
+			return c.report(path, "%v != %v", a, b)
+		}
+	}
+	return true
+}
+
+func (c *equalComparer) compareOrdered(path string, a, b reflect.Value) bool {
+	equal := true
+	for i := 0; i < a.Len() || i < b.Len(); i++ {
+		elemPath := path + "[" + strconv.Itoa(i) + "]"
+		switch {
+		case i >= a.Len():
+			equal = c.report(elemPath, "<missing> != %s", formatDiffValue(b.Index(i)))
+		case i >= b.Len():
+			equal = c.report(elemPath, "%s != <missing>", formatDiffValue(a.Index(i)))
+		case !c.compare(elemPath, a.Index(i), b.Index(i)):
+			equal = false
+		}
+		if !equal && c.silent {
+			return false
+		}
+	}
+	return equal
+}
+
+func (c *equalComparer) compareUnordered(path string, a, b reflect.Value) bool {
+	matched := make([]bool, b.Len())
+	var extraA []string
+	for i := 0; i < a.Len(); i++ {
+		found := false
+		for j := 0; j < b.Len(); j++ {
+			if !matched[j] && c.equal(a.Index(i), b.Index(j)) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			if c.silent {
+				return false
+			}
+			extraA = append(extraA, formatDiffValue(a.Index(i)))
+		}
+	}
+
+	var extraB []string
+	for j, ok := range matched {
+		if !ok {
+			if c.silent {
+				return false
+			}
+			extraB = append(extraB, formatDiffValue(b.Index(j)))
+		}
+	}
+
+	if len(extraA) > 0 {
+		c.report(path, "elements only in a: %s", strings.Join(extraA, ", "))
+	}
+	if len(extraB) > 0 {
+		c.report(path, "elements only in b: %s", strings.Join(extraB, ", "))
+	}
+	return len(extraA) == 0 && len(extraB) == 0
+}
+
+func (c *equalComparer) compareMaps(path string, a, b reflect.Value) bool {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return formatDiffValue(keys[i]) < formatDiffValue(keys[j])
+	})
+
+	equal := true
+	for _, key := range keys {
+		keyPath := path + "[" + formatDiffValue(key) + "]"
+		x, y := a.MapIndex(key), b.MapIndex(key)
+		switch {
+		case !x.IsValid():
+			equal = c.report(keyPath, "<missing> != %s", formatDiffValue(y))
+		case !y.IsValid():
+			equal = c.report(keyPath, "%s != <missing>", formatDiffValue(x))
+		case !c.compare(keyPath, x, y):
+			equal = false
+		}
+		if !equal && c.silent {
+			return false
+		}
+	}
+	return equal
+}
+
+func (c *equalComparer) compareStructs(path string, a, b reflect.Value) bool {
+	if method, ok := a.Type().MethodByName("Equal"); ok &&
+		method.Type.NumIn() == 2 && method.Type.In(1) == a.Type() &&
+		method.Type.NumOut() == 1 && method.Type.Out(0).Kind() == reflect.Bool {
+		if !a.Method(method.Index).Call([]reflect.Value{b})[0].Bool() {
+			return c.report(path, "%s != %s", formatDiffValue(a), formatDiffValue(b))
+		}
+		return true
+	}
+
+	a, b = addressable(a), addressable(b)
+	equal := true
+	for i := 0; i < a.NumField(); i++ {
+		name := a.Type().Field(i).Name
+		fieldPath := path + "." + name
+		if _, ok := c.ignored[name]; ok {
+			continue
+		}
+		if _, ok := c.ignored[strings.TrimPrefix(fieldPath, ".")]; ok {
+			continue
+		}
+		if !c.compare(fieldPath, accessible(a.Field(i)), accessible(b.Field(i))) {
+			equal = false
+			if c.silent {
+				return false
+			}
+		}
+	}
+	return equal
+}
+
+func (c *equalComparer) floatEqual(x, y float64) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return math.IsNaN(x) && math.IsNaN(y)
+	}
+	return x == y || math.Abs(x-y) <= c.opts.FloatTolerance
+}
+
+// addressable returns v itself if it is addressable, otherwise an addressable copy of it.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	return copied
+}
+
+// isEmptyCollection reports whether v is a slice or map of length 0, or an interface holding one.
+func isEmptyCollection(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Interface:
+		return isEmptyCollection(v.Elem())
+	}
+	return false
+}
+
+func formatDiffValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	if v.Kind() == reflect.String {
+		return strconv.Quote(v.String())
+	}
+	if !v.CanInterface() {
+		return v.String()
+	}
+	switch value := v.Interface().(type) {
+	case string:
+		return strconv.Quote(value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
@@ -0,0 +1,277 @@
+package fusion
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+type equalAddress struct {
+	City string
+	Zip  string
+}
+
+type equalUser struct {
+	Name      string
+	Tags      []string
+	Address   *equalAddress
+	Scores    map[string]float64
+	UpdatedAt time.Time
+	internal  int
+}
+
+func TestIsEqual(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	base := equalUser{
+		Name:      "ann",
+		Tags:      []string{"a", "b"},
+		Address:   &equalAddress{City: "Oslo"},
+		Scores:    map[string]float64{"math": 9.5},
+		UpdatedAt: now,
+		internal:  1,
+	}
+
+	testCases := []struct {
+		name     string
+		a        interface{}
+		b        interface{}
+		expected bool
+	}{
+		{
+			name:     "equal structs",
+			a:        base,
+			b:        CloneDeep(base),
+			expected: true,
+		},
+		{
+			name: "different nested field",
+			a:    base,
+			b: func() equalUser {
+				u := CloneDeep(base)
+				u.Address.City = "Bergen"
+				return u
+			}(),
+			expected: false,
+		},
+		{
+			name: "different unexported field",
+			a:    base,
+			b: func() equalUser {
+				u := CloneDeep(base)
+				u.internal = 2
+				return u
+			}(),
+			expected: false,
+		},
+		{
+			name: "same instant in another location",
+			a:    base,
+			b: func() equalUser {
+				u := CloneDeep(base)
+				u.UpdatedAt = now.In(time.FixedZone("X", 3600))
+				return u
+			}(),
+			expected: true,
+		},
+		{
+			name:     "NaN equals NaN",
+			a:        []float64{math.NaN()},
+			b:        []float64{math.NaN()},
+			expected: true,
+		},
+		{
+			name:     "nil and empty slices differ by default",
+			a:        []int(nil),
+			b:        []int{},
+			expected: false,
+		},
+		{
+			name:     "different types",
+			a:        1,
+			b:        int64(1),
+			expected: false,
+		},
+		{
+			name:     "both nil",
+			a:        nil,
+			b:        nil,
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := IsEqual(testCase.a, testCase.b); result != testCase.expected {
+				t.Errorf("expected %v but got %v (diff: %s)", testCase.expected, result, Diff(testCase.a, testCase.b))
+			}
+		})
+	}
+}
+
+func TestIsEqualWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		a        interface{}
+		b        interface{}
+		opts     EqualOptions
+		expected bool
+	}{
+		{
+			name:     "ignore order",
+			a:        []int{1, 2, 2, 3},
+			b:        []int{2, 3, 1, 2},
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: true,
+		},
+		{
+			name:     "ignore order respects duplicates",
+			a:        []int{1, 1, 2},
+			b:        []int{1, 2, 2},
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: false,
+		},
+		{
+			name:     "ignore order in nested values",
+			a:        map[string][]string{"k": {"x", "y"}},
+			b:        map[string][]string{"k": {"y", "x"}},
+			opts:     EqualOptions{IgnoreOrder: true},
+			expected: true,
+		},
+		{
+			name:     "nil equals empty slice",
+			a:        []int(nil),
+			b:        []int{},
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: true,
+		},
+		{
+			name:     "nil equals empty map",
+			a:        map[string]interface{}{"m": map[string]int{}},
+			b:        map[string]interface{}{"m": nil},
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: true,
+		},
+		{
+			name:     "nil does not equal scalar",
+			a:        nil,
+			b:        5,
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: false,
+		},
+		{
+			name:     "nil does not equal empty string",
+			a:        map[string]interface{}{"a": nil},
+			b:        map[string]interface{}{"a": ""},
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: false,
+		},
+		{
+			name:     "nil element does not equal value",
+			a:        []interface{}{nil},
+			b:        []interface{}{42},
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: false,
+		},
+		{
+			name:     "nil does not equal non-empty slice",
+			a:        map[string]interface{}{"a": nil},
+			b:        map[string]interface{}{"a": []int{1}},
+			opts:     EqualOptions{NilEqualsEmpty: true},
+			expected: false,
+		},
+		{
+			name:     "float tolerance",
+			a:        []float64{0.1 + 0.2},
+			b:        []float64{0.3},
+			opts:     EqualOptions{FloatTolerance: 1e-9},
+			expected: true,
+		},
+		{
+			name:     "float outside tolerance",
+			a:        1.0,
+			b:        1.1,
+			opts:     EqualOptions{FloatTolerance: 1e-9},
+			expected: false,
+		},
+		{
+			name:     "ignore field by name",
+			a:        equalUser{Name: "ann", UpdatedAt: time.Unix(1, 0)},
+			b:        equalUser{Name: "ann", UpdatedAt: time.Unix(2, 0)},
+			opts:     EqualOptions{IgnoreFields: []string{"UpdatedAt"}},
+			expected: true,
+		},
+		{
+			name:     "ignore field by path",
+			a:        equalUser{Address: &equalAddress{City: "Oslo", Zip: "1"}},
+			b:        equalUser{Address: &equalAddress{City: "Oslo", Zip: "2"}},
+			opts:     EqualOptions{IgnoreFields: []string{"Address.Zip"}},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := IsEqualWith(testCase.a, testCase.b, testCase.opts); result != testCase.expected {
+				t.Errorf("expected %v but got %v (diff: %s)", testCase.expected, result, DiffWith(testCase.a, testCase.b, testCase.opts))
+			}
+		})
+	}
+}
+
+func TestIsEqualCycles(t *testing.T) {
+	t.Parallel()
+
+	a := &cloneNode{Value: 1}
+	a.Next = a
+	b := &cloneNode{Value: 1}
+	b.Next = b
+
+	if !IsEqual(a, b) {
+		t.Errorf("expected equal cyclic structures to be equal")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	a := equalUser{
+		Name:    "ann",
+		Tags:    []string{"a", "b"},
+		Address: &equalAddress{City: "Oslo"},
+		Scores:  map[string]float64{"math": 9.5, "art": 7},
+	}
+	b := equalUser{
+		Name:    "bob",
+		Tags:    []string{"a", "c", "d"},
+		Address: &equalAddress{City: "Oslo"},
+		Scores:  map[string]float64{"math": 9, "music": 8},
+	}
+
+	expected := `.Name: "ann" != "bob"
+.Tags[1]: "b" != "c"
+.Tags[2]: <missing> != "d"
+.Scores["art"]: 7 != <missing>
+.Scores["math"]: 9.5 != 9
+.Scores["music"]: <missing> != 8`
+
+	if result := Diff(a, b); result != expected {
+		t.Errorf("expected:\n%s\nbut got:\n%s", expected, result)
+	}
+
+	if result := Diff(a, CloneDeep(a)); result != "" {
+		t.Errorf("expected no differences but got:\n%s", result)
+	}
+
+	if result := Diff(1, 2); result != "(root): 1 != 2" {
+		t.Errorf("expected a root difference but got %q", result)
+	}
+
+	unordered := DiffWith([]int{1, 2, 3}, []int{3, 4, 1}, EqualOptions{IgnoreOrder: true})
+	if expected := "(root): elements only in a: 2\n(root): elements only in b: 4"; unordered != expected {
+		t.Errorf("expected:\n%s\nbut got:\n%s", expected, unordered)
+	}
+}
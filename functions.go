@@ -0,0 +1,185 @@
+package fusion
+
+// And combines predicates into a single predicate that returns true only if all of them return true.
+// Evaluation stops at the first predicate that returns false. With no predicates it always returns true.
+func And[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if !predicate(value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// ArgMapper adapts a simple transformation function to the callback shape used by Map,
+// which is invoked with three arguments: (index, value, arg).
+func ArgMapper[T any, U any](fn func(T) U) func(int, T, interface{}) U {
+	return func(_ int, value T, _ interface{}) U {
+		return fn(value)
+	}
+}
+
+// ArgPredicate adapts a simple predicate to the callback shape used by Filter,
+// which is invoked with three arguments: (index, value, arg).
+func ArgPredicate[T any](predicate func(T) bool) func(int, T, interface{}) bool {
+	return func(_ int, value T, _ interface{}) bool {
+		return predicate(value)
+	}
+}
+
+// ArrayPredicate adapts a simple predicate to the callback shape used by Every, Some and Remove,
+// which is invoked with three arguments: (value, index, array).
+func ArrayPredicate[T any](predicate func(T) bool) func(T, int, []T) bool {
+	return func(value T, _ int, _ []T) bool {
+		return predicate(value)
+	}
+}
+
+// Compose2 composes two unary functions from right to left, so Compose2(f, g)(x) is f(g(x)).
+func Compose2[A, B, C any](f2 func(B) C, f1 func(A) B) func(A) C {
+	return Pipe2(f1, f2)
+}
+
+// Compose3 composes three unary functions from right to left.
+func Compose3[A, B, C, D any](f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) D {
+	return Pipe3(f1, f2, f3)
+}
+
+// Compose4 composes four unary functions from right to left.
+func Compose4[A, B, C, D, E any](f4 func(D) E, f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) E {
+	return Pipe4(f1, f2, f3, f4)
+}
+
+// Compose5 composes five unary functions from right to left.
+func Compose5[A, B, C, D, E, F any](f5 func(E) F, f4 func(D) E, f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) F {
+	return Pipe5(f1, f2, f3, f4, f5)
+}
+
+// Compose6 composes six unary functions from right to left.
+func Compose6[A, B, C, D, E, F, G any](f6 func(F) G, f5 func(E) F, f4 func(D) E, f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) G {
+	return Pipe6(f1, f2, f3, f4, f5, f6)
+}
+
+// Compose7 composes seven unary functions from right to left.
+func Compose7[A, B, C, D, E, F, G, H any](f7 func(G) H, f6 func(F) G, f5 func(E) F, f4 func(D) E, f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) H {
+	return Pipe7(f1, f2, f3, f4, f5, f6, f7)
+}
+
+// Compose8 composes eight unary functions from right to left.
+func Compose8[A, B, C, D, E, F, G, H, I any](f8 func(H) I, f7 func(G) H, f6 func(F) G, f5 func(E) F, f4 func(D) E, f3 func(C) D, f2 func(B) C, f1 func(A) B) func(A) I {
+	return Pipe8(f1, f2, f3, f4, f5, f6, f7, f8)
+}
+
+// Curry2 transforms a function of two arguments into a chain of unary functions.
+func Curry2[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return fn(a, b)
+		}
+	}
+}
+
+// Curry3 transforms a function of three arguments into a chain of unary functions.
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
+
+// Flip returns a function that invokes fn with its two arguments swapped.
+func Flip[A, B, R any](fn func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
+
+// Negate returns a predicate that returns the opposite of the given predicate.
+func Negate[T any](predicate func(T) bool) func(T) bool {
+	return func(value T) bool {
+		return !predicate(value)
+	}
+}
+
+// Or combines predicates into a single predicate that returns true if any of them returns true.
+// Evaluation stops at the first predicate that returns true. With no predicates it always returns false.
+func Or[T any](predicates ...func(T) bool) func(T) bool {
+	return func(value T) bool {
+		for _, predicate := range predicates {
+			if predicate(value) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Partial binds the first argument of a two-argument function and returns a unary function
+// that accepts the remaining argument.
+func Partial[A, B, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// PartialRight binds the last argument of a two-argument function and returns a unary function
+// that accepts the remaining argument.
+func PartialRight[A, B, R any](fn func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return fn(a, b)
+	}
+}
+
+// Pipe2 composes two unary functions from left to right, so Pipe2(f, g)(x) is g(f(x)).
+func Pipe2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(a A) C {
+		return f2(f1(a))
+	}
+}
+
+// Pipe3 composes three unary functions from left to right.
+func Pipe3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(a A) D {
+		return f3(f2(f1(a)))
+	}
+}
+
+// Pipe4 composes four unary functions from left to right.
+func Pipe4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(a A) E {
+		return f4(f3(f2(f1(a))))
+	}
+}
+
+// Pipe5 composes five unary functions from left to right.
+func Pipe5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(a A) F {
+		return f5(f4(f3(f2(f1(a)))))
+	}
+}
+
+// Pipe6 composes six unary functions from left to right.
+func Pipe6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(a A) G {
+		return f6(f5(f4(f3(f2(f1(a))))))
+	}
+}
+
+// Pipe7 composes seven unary functions from left to right.
+func Pipe7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(a A) H {
+		return f7(f6(f5(f4(f3(f2(f1(a)))))))
+	}
+}
+
+// Pipe8 composes eight unary functions from left to right.
+func Pipe8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(a A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+	}
+}
@@ -0,0 +1,196 @@
+package fusion
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func isPositive(value int) bool {
+	return value > 0
+}
+
+func TestAndOr(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       int
+		expectedAnd bool
+		expectedOr  bool
+	}{
+		{
+			name:        "both true",
+			input:       4,
+			expectedAnd: true,
+			expectedOr:  true,
+		},
+		{
+			name:        "one true",
+			input:       3,
+			expectedAnd: false,
+			expectedOr:  true,
+		},
+		{
+			name:        "none true",
+			input:       -3,
+			expectedAnd: false,
+			expectedOr:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := And(isEven, isPositive)(testCase.input); result != testCase.expectedAnd {
+				t.Errorf("And: expected %v but got %v", testCase.expectedAnd, result)
+			}
+			if result := Or(isEven, isPositive)(testCase.input); result != testCase.expectedOr {
+				t.Errorf("Or: expected %v but got %v", testCase.expectedOr, result)
+			}
+		})
+	}
+
+	if !And[int]()(1) {
+		t.Errorf("expected And with no predicates to return true")
+	}
+	if Or[int]()(1) {
+		t.Errorf("expected Or with no predicates to return false")
+	}
+}
+
+func TestAdapters(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3, 4, 5, 6}
+
+	filtered := Filter(input, ArgPredicate(isEven), nil)
+	if expected := []int{2, 4, 6}; !reflect.DeepEqual(filtered, expected) {
+		t.Errorf("Filter: expected %v but got %v", expected, filtered)
+	}
+
+	mapped := Map(input, ArgMapper(strconv.Itoa), nil)
+	if expected := []string{"1", "2", "3", "4", "5", "6"}; !reflect.DeepEqual(mapped, expected) {
+		t.Errorf("Map: expected %v but got %v", expected, mapped)
+	}
+
+	if !Every(input, ArrayPredicate(isPositive)) {
+		t.Errorf("Every: expected all values to be positive")
+	}
+	if !Some(input, ArrayPredicate(isEven)) {
+		t.Errorf("Some: expected some values to be even")
+	}
+
+	arr := []int{1, 2, 3, 4}
+	removed := Remove(&arr, ArrayPredicate(Negate(isEven)))
+	if expected := []int{1, 3}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("Remove: expected removed %v but got %v", expected, removed)
+	}
+	if expected := []int{2, 4}; !reflect.DeepEqual(arr, expected) {
+		t.Errorf("Remove: expected remaining %v but got %v", expected, arr)
+	}
+}
+
+func TestCurry(t *testing.T) {
+	t.Parallel()
+
+	add := func(a, b int) int { return a + b }
+	if result := Curry2(add)(2)(3); result != 5 {
+		t.Errorf("Curry2: expected 5 but got %d", result)
+	}
+
+	join3 := func(a, b, c string) string { return a + b + c }
+	if result := Curry3(join3)("a")("b")("c"); result != "abc" {
+		t.Errorf("Curry3: expected abc but got %s", result)
+	}
+}
+
+func TestFlip(t *testing.T) {
+	t.Parallel()
+
+	subtract := func(a, b int) int { return a - b }
+	if result := Flip(subtract)(2, 10); result != 8 {
+		t.Errorf("expected 8 but got %d", result)
+	}
+}
+
+func TestNegate(t *testing.T) {
+	t.Parallel()
+
+	isOdd := Negate(isEven)
+	if !isOdd(3) || isOdd(4) {
+		t.Errorf("expected Negate to invert the predicate")
+	}
+}
+
+func TestPartial(t *testing.T) {
+	t.Parallel()
+
+	if result := Partial(strings.Repeat, "ab")(3); result != "ababab" {
+		t.Errorf("Partial: expected ababab but got %s", result)
+	}
+	if result := PartialRight(strings.Repeat, 2)("xy"); result != "xyxy" {
+		t.Errorf("PartialRight: expected xyxy but got %s", result)
+	}
+}
+
+func TestPipeCompose(t *testing.T) {
+	t.Parallel()
+
+	double := func(value int) int { return value * 2 }
+	increment := func(value int) int { return value + 1 }
+
+	testCases := []struct {
+		name     string
+		fn       func(int) int
+		expected int
+	}{
+		{
+			name:     "Pipe2",
+			fn:       Pipe2(double, increment),
+			expected: 7,
+		},
+		{
+			name:     "Compose2",
+			fn:       Compose2(double, increment),
+			expected: 8,
+		},
+		{
+			name:     "Pipe4",
+			fn:       Pipe4(double, increment, double, increment),
+			expected: 15,
+		},
+		{
+			name:     "Compose4",
+			fn:       Compose4(double, increment, double, increment),
+			expected: 18,
+		},
+		{
+			name:     "Pipe8",
+			fn:       Pipe8(double, increment, double, increment, double, increment, double, increment),
+			expected: 63,
+		},
+		{
+			name:     "Compose8",
+			fn:       Compose8(double, increment, double, increment, double, increment, double, increment),
+			expected: 78,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := testCase.fn(3); result != testCase.expected {
+				t.Errorf("expected %d but got %d", testCase.expected, result)
+			}
+		})
+	}
+
+	toString := Pipe3(double, strconv.Itoa, func(s string) string { return "#" + s })
+	if result := toString(21); result != "#42" {
+		t.Errorf("expected #42 but got %s", result)
+	}
+}
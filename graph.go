@@ -0,0 +1,340 @@
+package fusion
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The functions in this file take a graph as an adjacency map, where graph[k] lists the nodes that k
+// has an edge to. For dependency maps, graph[k] lists the dependencies of k. Nodes that only appear
+// in adjacency lists are part of the graph too. Whenever the result depends on iteration order,
+// nodes are visited in ascending order: by value for strings and numbers, and by their %v
+// representation for other types.
+
+// CycleError is returned by TopoSort when the graph contains a cycle.
+type CycleError[K comparable] struct {
+	// Cycle lists the nodes of one cycle, starting and ending with the same node,
+	// so that each node has an edge to the next.
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	nodes := make([]string, len(e.Cycle))
+	for i, node := range e.Cycle {
+		nodes[i] = fmt.Sprint(node)
+	}
+	return "fusion: cycle: " + strings.Join(nodes, " -> ")
+}
+
+// BFS visits the nodes reachable from start in breadth-first order, calling visit with each node and
+// its distance from start, until visit returns false. Each node is visited once.
+func BFS[K comparable](graph map[K][]K, start K, visit func(node K, depth int) bool) {
+	seen := map[K]bool{start: true}
+	current := []K{start}
+	for depth := 0; len(current) > 0; depth++ {
+		var next []K
+		for _, node := range current {
+			if !visit(node, depth) {
+				return
+			}
+			for _, neighbor := range graph[node] {
+				if !seen[neighbor] {
+					seen[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		current = next
+	}
+}
+
+// DFS visits the nodes reachable from start in depth-first preorder, following edges in the order
+// they are listed, and calls visit with each node and its depth in the search tree until visit returns false.
+// Each node is visited once.
+func DFS[K comparable](graph map[K][]K, start K, visit func(node K, depth int) bool) {
+	type frame struct {
+		node  K
+		depth int
+	}
+
+	seen := make(map[K]bool)
+	stack := []frame{{node: start}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[top.node] {
+			continue
+		}
+		seen[top.node] = true
+		if !visit(top.node, top.depth) {
+			return
+		}
+
+		neighbors := graph[top.node]
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if !seen[neighbors[i]] {
+				stack = append(stack, frame{node: neighbors[i], depth: top.depth + 1})
+			}
+		}
+	}
+}
+
+// ShortestPath returns a path with the fewest edges from one node to another, including both ends,
+// or false if to is not reachable from from.
+func ShortestPath[K comparable](graph map[K][]K, from, to K) ([]K, bool) {
+	parents := map[K]K{}
+	found := from == to
+	BFS(graph, from, func(node K, _ int) bool {
+		for _, neighbor := range graph[node] {
+			if _, seen := parents[neighbor]; !seen && neighbor != from {
+				parents[neighbor] = node
+			}
+		}
+		if _, reached := parents[to]; reached {
+			found = true
+		}
+		return !found
+	})
+	if !found {
+		return nil, false
+	}
+	return buildPath(parents, from, to), true
+}
+
+// ShortestPathWeighted returns a path with the lowest total weight from one node to another using
+// Dijkstra's algorithm, along with that weight, or false if to is not reachable from from.
+// weight returns the weight of the edge between two adjacent nodes. It panics on a negative weight.
+func ShortestPathWeighted[K comparable, W Number](graph map[K][]K, from, to K, weight func(from, to K) W) ([]K, W, bool) {
+	type entry struct {
+		node     K
+		distance W
+	}
+
+	distances := map[K]W{from: 0}
+	parents := map[K]K{}
+	done := map[K]bool{}
+	queue := NewHeap(func(a, b entry) bool { return a.distance < b.distance })
+	queue.Push(entry{node: from})
+
+	for queue.Len() > 0 {
+		current, _ := queue.Pop()
+		if done[current.node] {
+			continue
+		}
+		done[current.node] = true
+		if current.node == to {
+			return buildPath(parents, from, to), current.distance, true
+		}
+
+		for _, neighbor := range graph[current.node] {
+			w := weight(current.node, neighbor)
+			if w < 0 {
+				panic("fusion: negative edge weight")
+			}
+			distance := current.distance + w
+			if known, ok := distances[neighbor]; !done[neighbor] && (!ok || distance < known) {
+				distances[neighbor] = distance
+				parents[neighbor] = current.node
+				queue.Push(entry{node: neighbor, distance: distance})
+			}
+		}
+	}
+
+	var zero W
+	return nil, zero, false
+}
+
+// StronglyConnectedComponents returns the strongly connected components of the graph using Tarjan's algorithm.
+// Each component is sorted, and a component is listed after every component it has an edge to,
+// so for dependency maps dependencies come first.
+func StronglyConnectedComponents[K comparable](graph map[K][]K) [][]K {
+	index := make(map[K]int)
+	lowLink := make(map[K]int)
+	onStack := make(map[K]bool)
+	var stack []K
+	var components [][]K
+
+	var connect func(node K)
+	connect = func(node K) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, neighbor := range graph[node] {
+			if _, visited := index[neighbor]; !visited {
+				connect(neighbor)
+				lowLink[node] = minOrdered(lowLink[node], lowLink[neighbor])
+			} else if onStack[neighbor] {
+				lowLink[node] = minOrdered(lowLink[node], index[neighbor])
+			}
+		}
+
+		if lowLink[node] == index[node] {
+			var component []K
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == node {
+					break
+				}
+			}
+			sortNodes(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range graphNodes(graph) {
+		if _, visited := index[node]; !visited {
+			connect(node)
+		}
+	}
+	return components
+}
+
+// TopoSort orders the nodes of a dependency map so that every node comes after the nodes it has an edge to.
+// Among nodes whose dependencies are all placed, the smallest comes first, so the order is deterministic.
+// If the graph has a cycle, it returns the nodes it could place and a *CycleError describing one cycle.
+func TopoSort[K comparable](graph map[K][]K) ([]K, error) {
+	nodes := graphNodes(graph)
+	pending := make(map[K]int, len(nodes))
+	dependents := make(map[K][]K, len(nodes))
+	for _, node := range nodes {
+		for _, dependency := range Uniq(graph[node]) {
+			pending[node]++
+			dependents[dependency] = append(dependents[dependency], node)
+		}
+	}
+
+	ready := NewHeap(lessNode[K])
+	for _, node := range nodes {
+		if pending[node] == 0 {
+			ready.Push(node)
+		}
+	}
+
+	order := make([]K, 0, len(nodes))
+	for ready.Len() > 0 {
+		node, _ := ready.Pop()
+		order = append(order, node)
+		for _, dependent := range dependents[node] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				ready.Push(dependent)
+			}
+		}
+	}
+
+	if len(order) < len(nodes) {
+		return order, &CycleError[K]{Cycle: findCycle(graph, nodes, pending)}
+	}
+	return order, nil
+}
+
+// TransitiveClosure returns, for every node, the sorted list of nodes reachable from it through at least one edge.
+// A node is only reachable from itself if it lies on a cycle.
+func TransitiveClosure[K comparable](graph map[K][]K) map[K][]K {
+	closure := make(map[K][]K)
+	for _, node := range graphNodes(graph) {
+		reachable := make([]K, 0)
+		seen := make(map[K]bool)
+		queue := append([]K(nil), graph[node]...)
+		for len(queue) > 0 {
+			next := queue[0]
+			queue = queue[1:]
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			reachable = append(reachable, next)
+			queue = append(queue, graph[next]...)
+		}
+		sortNodes(reachable)
+		closure[node] = reachable
+	}
+	return closure
+}
+
+// buildPath follows parents back from to and returns the path from from to to.
+func buildPath[K comparable](parents map[K]K, from, to K) []K {
+	path := []K{to}
+	for node := to; node != from; {
+		node = parents[node]
+		path = append(path, node)
+	}
+	Reverse(path)
+	return path
+}
+
+// findCycle returns a cycle among the nodes that TopoSort could not place, which all still have
+// a pending dependency that was not placed either.
+func findCycle[K comparable](graph map[K][]K, nodes []K, pending map[K]int) []K {
+	var start K
+	for _, node := range nodes {
+		if pending[node] > 0 {
+			start = node
+			break
+		}
+	}
+
+	position := make(map[K]int)
+	var path []K
+	for node := start; ; {
+		if i, seen := position[node]; seen {
+			return append(path[i:], node)
+		}
+		position[node] = len(path)
+		path = append(path, node)
+
+		dependencies := Filter(graph[node], func(_ int, dependency K, _ interface{}) bool {
+			return pending[dependency] > 0
+		}, nil)
+		sortNodes(dependencies)
+		node = dependencies[0]
+	}
+}
+
+// graphNodes returns every node of the graph in ascending order.
+func graphNodes[K comparable](graph map[K][]K) []K {
+	seen := make(map[K]bool, len(graph))
+	nodes := make([]K, 0, len(graph))
+	add := func(node K) {
+		if !seen[node] {
+			seen[node] = true
+			nodes = append(nodes, node)
+		}
+	}
+	for node, neighbors := range graph {
+		add(node)
+		for _, neighbor := range neighbors {
+			add(neighbor)
+		}
+	}
+	sortNodes(nodes)
+	return nodes
+}
+
+// lessNode orders strings and numbers by value, including named types based on them,
+// and other comparable values by their %v representation.
+func lessNode[K comparable](a, b K) bool {
+	va, vb := reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem()
+	switch va.Kind() {
+	case reflect.String:
+		return va.String() < vb.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return va.Int() < vb.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return va.Uint() < vb.Uint()
+	case reflect.Float32, reflect.Float64:
+		return va.Float() < vb.Float()
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func sortNodes[K comparable](nodes []K) {
+	sort.SliceStable(nodes, func(i, j int) bool { return lessNode(nodes[i], nodes[j]) })
+}
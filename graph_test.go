@@ -0,0 +1,278 @@
+package fusion
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTopoSort(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		graph    map[string][]string
+		expected []string
+		cycle    []string
+	}{
+		{
+			name: "build order",
+			graph: map[string][]string{
+				"app":    {"lib", "config"},
+				"lib":    {"util"},
+				"config": {"util"},
+				"tests":  {"app", "util"},
+			},
+			expected: []string{"util", "config", "lib", "app", "tests"},
+		},
+		{
+			name:     "independent nodes sorted",
+			graph:    map[string][]string{"c": nil, "a": nil, "b": {}},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "duplicate dependencies",
+			graph:    map[string][]string{"a": {"b", "b"}},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "empty graph",
+			graph:    map[string][]string{},
+			expected: []string{},
+		},
+		{
+			name: "cycle",
+			graph: map[string][]string{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"a", "d"},
+				"d": nil,
+			},
+			expected: []string{"d"},
+			cycle:    []string{"a", "b", "c", "a"},
+		},
+		{
+			name:     "self loop",
+			graph:    map[string][]string{"a": {"a"}},
+			expected: []string{},
+			cycle:    []string{"a", "a"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			order, err := TopoSort(testCase.graph)
+			if !IsEqual(order, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, order)
+			}
+
+			var cycleErr *CycleError[string]
+			if testCase.cycle == nil {
+				if err != nil {
+					t.Errorf("expected no error but got %v", err)
+				}
+				return
+			}
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("expected a *CycleError but got %v", err)
+			}
+			if !IsEqual(cycleErr.Cycle, testCase.cycle) {
+				t.Errorf("expected cycle %v but got %v", testCase.cycle, cycleErr.Cycle)
+			}
+		})
+	}
+
+	_, err := TopoSort(map[int][]int{1: {2}, 2: {1}})
+	if expected := "fusion: cycle: 1 -> 2 -> 1"; err == nil || err.Error() != expected {
+		t.Errorf("expected %q but got %v", expected, err)
+	}
+}
+
+func TestTopoSortOrder(t *testing.T) {
+	t.Parallel()
+
+	// Integers are ordered numerically rather than by their text.
+	order, err := TopoSort(map[int][]int{10: nil, 9: nil, 100: {9}})
+	if expected := []int{9, 10, 100}; err != nil || !IsEqual(order, expected) {
+		t.Errorf("expected %v but got %v (%v)", expected, order, err)
+	}
+
+	type task struct{ Name string }
+	graph := map[task][]task{{"b"}: {{"a"}}, {"c"}: nil}
+	order2, err := TopoSort(graph)
+	if expected := []task{{"a"}, {"b"}, {"c"}}; err != nil || !IsEqual(order2, expected) {
+		t.Errorf("expected %v but got %v (%v)", expected, order2, err)
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c", "e"},
+		"c": {"a", "d"},
+		"d": {"e"},
+		"e": {"f"},
+		"f": {"d"},
+		"g": nil,
+	}
+
+	expected := [][]string{{"d", "e", "f"}, {"a", "b", "c"}, {"g"}}
+	if result := StronglyConnectedComponents(graph); !IsEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestBFSDFS(t *testing.T) {
+	t.Parallel()
+
+	graph := map[int][]int{
+		1: {2, 3},
+		2: {4},
+		3: {4, 5},
+		4: {1},
+		5: {6},
+	}
+
+	type visit struct{ node, depth int }
+	collect := func(search func(map[int][]int, int, func(int, int) bool), limit int) []visit {
+		var visits []visit
+		search(graph, 1, func(node, depth int) bool {
+			visits = append(visits, visit{node, depth})
+			return len(visits) < limit
+		})
+		return visits
+	}
+
+	testCases := []struct {
+		name     string
+		search   func(map[int][]int, int, func(int, int) bool)
+		limit    int
+		expected []visit
+	}{
+		{
+			name:     "BFS",
+			search:   BFS[int],
+			limit:    100,
+			expected: []visit{{1, 0}, {2, 1}, {3, 1}, {4, 2}, {5, 2}, {6, 3}},
+		},
+		{
+			name:     "DFS",
+			search:   DFS[int],
+			limit:    100,
+			expected: []visit{{1, 0}, {2, 1}, {4, 2}, {3, 1}, {5, 2}, {6, 3}},
+		},
+		{
+			name:     "BFS stops early",
+			search:   BFS[int],
+			limit:    2,
+			expected: []visit{{1, 0}, {2, 1}},
+		},
+		{
+			name:     "DFS stops early",
+			search:   DFS[int],
+			limit:    3,
+			expected: []visit{{1, 0}, {2, 1}, {4, 2}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := collect(testCase.search, testCase.limit); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string][]string{
+		"home":   {"park", "shop"},
+		"park":   {"school"},
+		"shop":   {"mall"},
+		"mall":   {"school"},
+		"school": {"home"},
+	}
+
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []string
+		found    bool
+	}{
+		{name: "fewest edges", from: "home", to: "school", expected: []string{"home", "park", "school"}, found: true},
+		{name: "same node", from: "mall", to: "mall", expected: []string{"mall"}, found: true},
+		{name: "around a cycle", from: "mall", to: "park", expected: []string{"mall", "school", "home", "park"}, found: true},
+		{name: "unreachable", from: "home", to: "beach", found: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path, found := ShortestPath(graph, testCase.from, testCase.to)
+			if found != testCase.found || !IsEqualWith(path, testCase.expected, EqualOptions{NilEqualsEmpty: true}) {
+				t.Errorf("expected %v (%v) but got %v (%v)", testCase.expected, testCase.found, path, found)
+			}
+		})
+	}
+}
+
+func TestShortestPathWeighted(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"b", "d"},
+		"d": nil,
+	}
+	weights := map[[2]string]float64{
+		{"a", "b"}: 10,
+		{"a", "c"}: 1,
+		{"c", "b"}: 2,
+		{"b", "d"}: 1,
+		{"c", "d"}: 5,
+	}
+	weight := func(from, to string) float64 { return weights[[2]string{from, to}] }
+
+	path, distance, found := ShortestPathWeighted(graph, "a", "d", weight)
+	if expected := []string{"a", "c", "b", "d"}; !found || distance != 4 || !IsEqual(path, expected) {
+		t.Errorf("expected %v with weight 4 but got %v with weight %v", expected, path, distance)
+	}
+
+	if _, _, found := ShortestPathWeighted(graph, "d", "a", weight); found {
+		t.Errorf("expected a to be unreachable from d")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a negative weight to panic")
+		}
+	}()
+	ShortestPathWeighted(graph, "a", "d", func(string, string) int { return -1 })
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": nil,
+		"x": {"y"},
+		"y": {"x"},
+	}
+
+	expected := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"c"},
+		"c": {},
+		"x": {"x", "y"},
+		"y": {"x", "y"},
+	}
+	if result := TransitiveClosure(graph); !IsEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
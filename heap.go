@@ -0,0 +1,238 @@
+package fusion
+
+// Heap is a binary heap ordered by a less function, so the element for which less reports true
+// against every other element is at the top. The zero value is not usable; create heaps with NewHeap.
+type Heap[T any] struct {
+	data []T
+	less func(a, b T) bool
+	// moved, if set, is called whenever an element is stored at a new index.
+	moved func(value T, i int)
+}
+
+// NewHeap returns an empty heap ordered by less.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// HeapFromSlice returns a heap holding a copy of the elements of arr, built in O(n).
+func HeapFromSlice[T any](arr []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{data: append([]T(nil), arr...), less: less}
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// NewMaxHeap returns an empty heap with the largest element at the top.
+func NewMaxHeap[T Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a > b })
+}
+
+// NewMinHeap returns an empty heap with the smallest element at the top.
+func NewMinHeap[T Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a < b })
+}
+
+// Fix restores the heap order after the element at index i has changed. It panics if i is out of range.
+func (h *Heap[T]) Fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.data)
+}
+
+// Peek returns the top element without removing it, or false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[0], true
+}
+
+// Pop removes and returns the top element, or returns false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Remove(0), true
+}
+
+// Push adds value to the heap in O(log n).
+func (h *Heap[T]) Push(value T) {
+	h.data = append(h.data, value)
+	h.setMoved(len(h.data) - 1)
+	h.up(len(h.data) - 1)
+}
+
+// Remove removes and returns the element at index i. It panics if i is out of range.
+func (h *Heap[T]) Remove(i int) T {
+	last := len(h.data) - 1
+	value := h.data[i]
+	if i != last {
+		h.swap(i, last)
+	}
+
+	var zero T
+	h.data[last] = zero
+	h.data = h.data[:last]
+	if i != last {
+		h.Fix(i)
+	}
+	return value
+}
+
+// ToSlice returns a copy of the elements in heap order, the order used by the indices of Fix and Remove.
+func (h *Heap[T]) ToSlice() []T {
+	return append([]T(nil), h.data...)
+}
+
+// down moves the element at index i towards the leaves and reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	n := len(h.data)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.data[right], h.data[child]) {
+			child = right
+		}
+		if !h.less(h.data[child], h.data[i]) {
+			break
+		}
+		h.swap(i, child)
+		i = child
+	}
+	return i > start
+}
+
+func (h *Heap[T]) setMoved(i int) {
+	if h.moved != nil {
+		h.moved(h.data[i], i)
+	}
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.setMoved(i)
+	h.setMoved(j)
+}
+
+// up moves the element at index i towards the root.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.data[i], h.data[parent]) {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// PriorityQueue is a heap of unique keys, each with a priority that can be changed after insertion.
+// The zero value is not usable; create queues with NewPriorityQueue.
+type PriorityQueue[K comparable, P any] struct {
+	heap    *Heap[*priorityItem[K, P]]
+	indices map[K]int
+}
+
+type priorityItem[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+// NewPriorityQueue returns an empty queue that pops the key whose priority is less than all others first.
+func NewPriorityQueue[K comparable, P any](less func(a, b P) bool) *PriorityQueue[K, P] {
+	pq := &PriorityQueue[K, P]{indices: make(map[K]int)}
+	pq.heap = NewHeap(func(a, b *priorityItem[K, P]) bool {
+		return less(a.priority, b.priority)
+	})
+	pq.heap.moved = func(item *priorityItem[K, P], i int) {
+		pq.indices[item.key] = i
+	}
+	return pq
+}
+
+// Contains reports whether key is in the queue.
+func (pq *PriorityQueue[K, P]) Contains(key K) bool {
+	_, ok := pq.indices[key]
+	return ok
+}
+
+// Len returns the number of keys in the queue.
+func (pq *PriorityQueue[K, P]) Len() int {
+	return pq.heap.Len()
+}
+
+// Peek returns the key with the lowest priority and its priority without removing it,
+// or false if the queue is empty.
+func (pq *PriorityQueue[K, P]) Peek() (K, P, bool) {
+	item, ok := pq.heap.Peek()
+	if !ok {
+		var key K
+		var priority P
+		return key, priority, false
+	}
+	return item.key, item.priority, true
+}
+
+// Pop removes and returns the key with the lowest priority and its priority,
+// or returns false if the queue is empty.
+func (pq *PriorityQueue[K, P]) Pop() (K, P, bool) {
+	item, ok := pq.heap.Pop()
+	if !ok {
+		var key K
+		var priority P
+		return key, priority, false
+	}
+	delete(pq.indices, item.key)
+	return item.key, item.priority, true
+}
+
+// Priority returns the priority of key, or false if key is not in the queue.
+func (pq *PriorityQueue[K, P]) Priority(key K) (P, bool) {
+	i, ok := pq.indices[key]
+	if !ok {
+		var priority P
+		return priority, false
+	}
+	return pq.heap.data[i].priority, true
+}
+
+// Push adds key with the given priority, or updates its priority if key is already in the queue.
+func (pq *PriorityQueue[K, P]) Push(key K, priority P) {
+	if !pq.UpdatePriority(key, priority) {
+		pq.heap.Push(&priorityItem[K, P]{key: key, priority: priority})
+	}
+}
+
+// Remove removes key from the queue and returns its priority, or false if key is not in the queue.
+func (pq *PriorityQueue[K, P]) Remove(key K) (P, bool) {
+	i, ok := pq.indices[key]
+	if !ok {
+		var priority P
+		return priority, false
+	}
+	item := pq.heap.Remove(i)
+	delete(pq.indices, key)
+	return item.priority, true
+}
+
+// UpdatePriority changes the priority of key in O(log n). It returns false if key is not in the queue.
+func (pq *PriorityQueue[K, P]) UpdatePriority(key K, priority P) bool {
+	i, ok := pq.indices[key]
+	if !ok {
+		return false
+	}
+	pq.heap.data[i].priority = priority
+	pq.heap.Fix(i)
+	return true
+}
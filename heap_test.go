@@ -0,0 +1,253 @@
+package fusion
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func drainHeap[T any](h *Heap[T]) []T {
+	var result []T
+	for {
+		value, ok := h.Pop()
+		if !ok {
+			return result
+		}
+		result = append(result, value)
+	}
+}
+
+func TestHeap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		heap     func(arr []int) *Heap[int]
+		expected []int
+	}{
+		{
+			name: "min heap",
+			heap: func(arr []int) *Heap[int] {
+				h := NewMinHeap[int]()
+				for _, value := range arr {
+					h.Push(value)
+				}
+				return h
+			},
+			expected: []int{1, 1, 2, 3, 4, 5, 6, 9},
+		},
+		{
+			name: "max heap",
+			heap: func(arr []int) *Heap[int] {
+				h := NewMaxHeap[int]()
+				for _, value := range arr {
+					h.Push(value)
+				}
+				return h
+			},
+			expected: []int{9, 6, 5, 4, 3, 2, 1, 1},
+		},
+		{
+			name: "from slice",
+			heap: func(arr []int) *Heap[int] {
+				return HeapFromSlice(arr, func(a, b int) bool { return a < b })
+			},
+			expected: []int{1, 1, 2, 3, 4, 5, 6, 9},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			input := []int{3, 1, 4, 1, 5, 9, 2, 6}
+			h := testCase.heap(input)
+
+			if h.Len() != len(input) {
+				t.Errorf("expected length %d but got %d", len(input), h.Len())
+			}
+			if top, ok := h.Peek(); !ok || top != testCase.expected[0] {
+				t.Errorf("expected top %d but got %d", testCase.expected[0], top)
+			}
+			if result := drainHeap(h); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+			if expected := []int{3, 1, 4, 1, 5, 9, 2, 6}; !IsEqual(input, expected) {
+				t.Errorf("expected input to be unchanged but got %v", input)
+			}
+		})
+	}
+
+	empty := NewMinHeap[string]()
+	if _, ok := empty.Pop(); ok {
+		t.Errorf("expected Pop on an empty heap to return false")
+	}
+	if _, ok := empty.Peek(); ok {
+		t.Errorf("expected Peek on an empty heap to return false")
+	}
+}
+
+func TestHeapFixRemove(t *testing.T) {
+	t.Parallel()
+
+	type task struct {
+		name     string
+		priority int
+	}
+
+	h := NewHeap(func(a, b *task) bool { return a.priority < b.priority })
+	tasks := []*task{{"a", 5}, {"b", 3}, {"c", 8}, {"d", 1}}
+	for _, item := range tasks {
+		h.Push(item)
+	}
+
+	// Raise the priority of "c" in place, then restore the heap order.
+	for i, item := range h.ToSlice() {
+		if item.name == "c" {
+			item.priority = 0
+			h.Fix(i)
+		}
+	}
+	// Remove "a" by its index.
+	for i, item := range h.ToSlice() {
+		if item.name == "a" {
+			if removed := h.Remove(i); removed != item {
+				t.Errorf("expected %v but got %v", item, removed)
+			}
+		}
+	}
+
+	var names []string
+	for _, item := range drainHeap(h) {
+		names = append(names, item.name)
+	}
+	if expected := []string{"c", "d", "b"}; !IsEqual(names, expected) {
+		t.Errorf("expected %v but got %v", expected, names)
+	}
+}
+
+func TestHeapRandom(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		input := make([]int, rng.Intn(200))
+		for i := range input {
+			input[i] = rng.Intn(100)
+		}
+
+		h := HeapFromSlice(input, func(a, b int) bool { return a < b })
+		expected := append([]int(nil), input...)
+		for i := 0; i < len(input)/4; i++ {
+			removed := h.Remove(rng.Intn(h.Len()))
+			index := IndexOf(expected, removed)
+			expected = append(expected[:index], expected[index+1:]...)
+		}
+		for i := 0; i < 20; i++ {
+			value := rng.Intn(100)
+			h.Push(value)
+			expected = append(expected, value)
+		}
+
+		sort.Ints(expected)
+		if result := drainHeap(h); !IsEqual(result, expected) {
+			t.Fatalf("round %d: expected %v but got %v", round, expected, result)
+		}
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	t.Parallel()
+
+	pq := NewPriorityQueue[string](func(a, b int) bool { return a < b })
+	pq.Push("write", 3)
+	pq.Push("read", 1)
+	pq.Push("sleep", 9)
+	pq.Push("eat", 5)
+
+	if !pq.UpdatePriority("sleep", 0) {
+		t.Errorf("expected UpdatePriority to find sleep")
+	}
+	if pq.UpdatePriority("missing", 0) {
+		t.Errorf("expected UpdatePriority to report a missing key")
+	}
+	pq.Push("read", 7)
+	if priority, ok := pq.Priority("read"); !ok || priority != 7 {
+		t.Errorf("expected priority 7 but got %d", priority)
+	}
+	if priority, ok := pq.Remove("write"); !ok || priority != 3 {
+		t.Errorf("expected to remove write with priority 3 but got %d", priority)
+	}
+	if pq.Contains("write") {
+		t.Errorf("expected write to be removed")
+	}
+	if key, priority, ok := pq.Peek(); !ok || key != "sleep" || priority != 0 {
+		t.Errorf("expected sleep with priority 0 but got %s with %d", key, priority)
+	}
+	if pq.Len() != 3 {
+		t.Errorf("expected length 3 but got %d", pq.Len())
+	}
+
+	var keys []string
+	for {
+		key, _, ok := pq.Pop()
+		if !ok {
+			break
+		}
+		keys = append(keys, key)
+	}
+	if expected := []string{"sleep", "eat", "read"}; !IsEqual(keys, expected) {
+		t.Errorf("expected %v but got %v", expected, keys)
+	}
+}
+
+func TestPriorityQueueRandom(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	pq := NewPriorityQueue[int](func(a, b int) bool { return a < b })
+	reference := make(map[int]int)
+
+	for step := 0; step < 2000; step++ {
+		key := rng.Intn(50)
+		switch rng.Intn(3) {
+		case 0:
+			priority := rng.Intn(1000)
+			pq.Push(key, priority)
+			reference[key] = priority
+		case 1:
+			_, ok := pq.Remove(key)
+			if _, expected := reference[key]; ok != expected {
+				t.Fatalf("step %d: expected Remove(%d) to return %v", step, key, expected)
+			}
+			delete(reference, key)
+		case 2:
+			key, priority, ok := pq.Pop()
+			if !ok {
+				if len(reference) != 0 {
+					t.Fatalf("step %d: expected Pop to return a key", step)
+				}
+				continue
+			}
+			for _, other := range reference {
+				if other < priority {
+					t.Fatalf("step %d: popped priority %d but %d is lower", step, priority, other)
+				}
+			}
+			if reference[key] != priority {
+				t.Fatalf("step %d: expected priority %d for %d but got %d", step, reference[key], key, priority)
+			}
+			delete(reference, key)
+		}
+		if pq.Len() != len(reference) {
+			t.Fatalf("step %d: expected length %d but got %d", step, len(reference), pq.Len())
+		}
+	}
+
+	var priorities []int
+	for pq.Len() > 0 {
+		_, priority, _ := pq.Pop()
+		priorities = append(priorities, priority)
+	}
+	if !sort.IntsAreSorted(priorities) {
+		t.Errorf("expected priorities in order but got %v", priorities)
+	}
+}
@@ -1,26 +1,32 @@
 package fusion
 
-import (
-	"fmt"
-	"sort"
-)
-
-// Check interface{} slices for equality
-func interfaceSliceEqual(a, b []interface{}) bool {
-	if len(a) != len(b) {
-		return false
-	}
-	for i := range a {
-		if a[i] != b[i] {
-			return false
+// forwardFromIndex resolves a lodash-style fromIndex for a search running towards the end of a slice.
+// Negative values are offsets from the end; the result is clamped to [0, length].
+func forwardFromIndex(length, fromIndex int) int {
+	if fromIndex < 0 {
+		fromIndex += length
+		if fromIndex < 0 {
+			fromIndex = 0
 		}
 	}
-	return true
+	if fromIndex > length {
+		fromIndex = length
+	}
+	return fromIndex
 }
 
-// Helper function to sort a slice of interface{} elements
-func sortSlice(slice []interface{}) {
-	sort.Slice(slice, func(i, j int) bool {
-		return fmt.Sprintf("%v", slice[i]) < fmt.Sprintf("%v", slice[j])
-	})
+// backwardFromIndex resolves a lodash-style fromIndex for a search running towards the start of a slice.
+// Negative values are offsets from the end; the result is clamped to [0, length-1],
+// or -1 for an empty slice.
+func backwardFromIndex(length, fromIndex int) int {
+	if fromIndex < 0 {
+		fromIndex += length
+		if fromIndex < 0 {
+			fromIndex = 0
+		}
+	}
+	if fromIndex > length-1 {
+		fromIndex = length - 1
+	}
+	return fromIndex
 }
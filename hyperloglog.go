@@ -0,0 +1,139 @@
+package fusion
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+const (
+	// MinHyperLogLogPrecision is the smallest precision accepted by NewHyperLogLog.
+	MinHyperLogLogPrecision = 4
+	// MaxHyperLogLogPrecision is the largest precision accepted by NewHyperLogLog.
+	MaxHyperLogLogPrecision = 18
+
+	hyperLogLogVersion = 1
+)
+
+var (
+	// ErrPrecisionMismatch is returned when merging HyperLogLogs with different precisions.
+	ErrPrecisionMismatch = errors.New("fusion: HyperLogLog precisions differ")
+	// ErrInvalidEncoding is returned when decoding malformed binary data.
+	ErrInvalidEncoding = errors.New("fusion: invalid binary encoding")
+)
+
+// HyperLogLog estimates the number of distinct values added to it using 2^precision bytes of memory.
+// The standard error of the estimate is about 1.04 / sqrt(2^precision), so precision 14 gives about 0.8%.
+// The zero value is not usable until UnmarshalBinary is called; otherwise create it with NewHyperLogLog.
+type HyperLogLog[T any] struct {
+	precision uint8
+	registers []uint8
+	hasher    Hasher[T]
+}
+
+// NewHyperLogLog returns an empty estimator with the given precision. A nil hasher selects DefaultHasher.
+// It panics if precision is outside MinHyperLogLogPrecision and MaxHyperLogLogPrecision.
+func NewHyperLogLog[T any](precision int, hasher Hasher[T]) *HyperLogLog[T] {
+	if precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision {
+		panic("fusion: HyperLogLog precision must be between 4 and 18")
+	}
+	return &HyperLogLog[T]{
+		precision: uint8(precision),
+		registers: make([]uint8, 1<<precision),
+		hasher:    hasherOrDefault(hasher),
+	}
+}
+
+// Add records value.
+func (h *HyperLogLog[T]) Add(value T) {
+	hash := mixHash(h.hasher(value))
+	index := hash >> (64 - h.precision)
+	// The low bit guard keeps the rank bounded when the remaining bits are all zero.
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Count returns the estimated number of distinct values added.
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range h.registers {
+		sum += math.Ldexp(1, -int(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+
+	estimate := hyperLogLogAlpha(len(h.registers)) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate while many registers are still empty.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// MarshalBinary encodes the estimator as a version byte, the precision and the registers.
+// The hasher is not encoded.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 2+len(h.registers))
+	data = append(data, hyperLogLogVersion, h.precision)
+	return append(data, h.registers...), nil
+}
+
+// Merge adds the values recorded by other, so h estimates the size of the union of both.
+// It returns ErrPrecisionMismatch if the precisions differ.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return ErrPrecisionMismatch
+	}
+	for i, register := range other.registers {
+		if register > h.registers[i] {
+			h.registers[i] = register
+		}
+	}
+	return nil
+}
+
+// Precision returns the precision of the estimator.
+func (h *HyperLogLog[T]) Precision() int {
+	return int(h.precision)
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary, replacing the state of h.
+// The hasher of h is kept, or set to DefaultHasher if h has none, so the same hasher must be used
+// for the estimate to stay meaningful.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != hyperLogLogVersion {
+		return ErrInvalidEncoding
+	}
+	precision := int(data[1])
+	if precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision || len(data) != 2+1<<precision {
+		return ErrInvalidEncoding
+	}
+	for _, register := range data[2:] {
+		if int(register) > 64-precision+1 {
+			return ErrInvalidEncoding
+		}
+	}
+
+	h.precision = uint8(precision)
+	h.registers = append([]uint8(nil), data[2:]...)
+	h.hasher = hasherOrDefault(h.hasher)
+	return nil
+}
+
+// hyperLogLogAlpha returns the bias correction constant for m registers.
+func hyperLogLogAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
@@ -0,0 +1,137 @@
+package fusion
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		precision int
+		distinct  int
+		tolerance float64
+	}{
+		{name: "empty", precision: 14, distinct: 0, tolerance: 0},
+		{name: "small", precision: 14, distinct: 100, tolerance: 0.02},
+		{name: "large", precision: 14, distinct: 100000, tolerance: 0.03},
+		{name: "low precision", precision: 4, distinct: 1000, tolerance: 0.6},
+		{name: "high precision", precision: 18, distinct: 50000, tolerance: 0.01},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			hll := NewHyperLogLog[string](testCase.precision, nil)
+			for i := 0; i < testCase.distinct; i++ {
+				value := "item-" + strconv.Itoa(i)
+				hll.Add(value)
+				hll.Add(value)
+			}
+
+			count := float64(hll.Count())
+			if diff := math.Abs(count - float64(testCase.distinct)); diff > testCase.tolerance*float64(testCase.distinct) {
+				t.Errorf("expected about %d but got %v", testCase.distinct, count)
+			}
+		})
+	}
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	t.Parallel()
+
+	a := NewHyperLogLog[int](12, nil)
+	b := NewHyperLogLog[int](12, nil)
+	for i := 0; i < 6000; i++ {
+		a.Add(i)
+	}
+	for i := 4000; i < 10000; i++ {
+		b.Add(i)
+	}
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+	if count := float64(a.Count()); math.Abs(count-10000) > 500 {
+		t.Errorf("expected about 10000 but got %v", count)
+	}
+
+	if err := a.Merge(NewHyperLogLog[int](10, nil)); !errors.Is(err, ErrPrecisionMismatch) {
+		t.Errorf("expected %v but got %v", ErrPrecisionMismatch, err)
+	}
+}
+
+func TestHyperLogLogBinary(t *testing.T) {
+	t.Parallel()
+
+	hll := NewHyperLogLog[int](10, nil)
+	for i := 0; i < 3000; i++ {
+		hll.Add(i)
+	}
+
+	data, err := hll.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+	if len(data) != 2+1024 {
+		t.Errorf("expected %d bytes but got %d", 2+1024, len(data))
+	}
+
+	var decoded HyperLogLog[int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+	if decoded.Count() != hll.Count() || decoded.Precision() != 10 {
+		t.Errorf("expected count %d but got %d", hll.Count(), decoded.Count())
+	}
+
+	// The decoded estimator keeps counting with the default hasher.
+	before := decoded.Count()
+	for i := 0; i < 3000; i++ {
+		decoded.Add(i)
+	}
+	if decoded.Count() != before {
+		t.Errorf("expected re-adding values to keep the count at %d but got %d", before, decoded.Count())
+	}
+
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "wrong version", data: append([]byte{2}, data[1:]...)},
+		{name: "precision out of range", data: append([]byte{1, 19}, data[2:]...)},
+		{name: "truncated", data: data[:100]},
+		{name: "register out of range", data: append(append([]byte(nil), data[:len(data)-1]...), 60)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var target HyperLogLog[int]
+			if err := target.UnmarshalBinary(testCase.data); !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("expected %v but got %v", ErrInvalidEncoding, err)
+			}
+		})
+	}
+}
+
+func TestHyperLogLogPanics(t *testing.T) {
+	t.Parallel()
+
+	for _, precision := range []int{3, 19} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected precision %d to panic", precision)
+				}
+			}()
+			NewHyperLogLog[int](precision, nil)
+		}()
+	}
+}
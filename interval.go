@@ -0,0 +1,211 @@
+package fusion
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interval is the half-open range [Start, End). An interval whose End is not after its Start is empty.
+// For time ranges, use a numeric representation such as Unix nanoseconds.
+type Interval[T Ordered] struct {
+	Start T
+	End   T
+}
+
+// NewInterval returns the interval [start, end).
+func NewInterval[T Ordered](start, end T) Interval[T] {
+	return Interval[T]{Start: start, End: end}
+}
+
+// Contains reports whether value lies in the interval.
+func (iv Interval[T]) Contains(value T) bool {
+	return !(value < iv.Start) && value < iv.End
+}
+
+// Intersect returns the overlap of two intervals, or false if they do not overlap.
+func (iv Interval[T]) Intersect(other Interval[T]) (Interval[T], bool) {
+	result := Interval[T]{Start: maxOrdered(iv.Start, other.Start), End: minOrdered(iv.End, other.End)}
+	if result.IsEmpty() {
+		return Interval[T]{}, false
+	}
+	return result, true
+}
+
+// IsEmpty reports whether the interval contains no values.
+func (iv Interval[T]) IsEmpty() bool {
+	return !(iv.Start < iv.End)
+}
+
+// Overlaps reports whether the intervals share at least one value.
+// Intervals that only touch, such as [1, 3) and [3, 5), do not overlap.
+func (iv Interval[T]) Overlaps(other Interval[T]) bool {
+	_, ok := iv.Intersect(other)
+	return ok
+}
+
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("[%v, %v)", iv.Start, iv.End)
+}
+
+// MergeIntervals returns the union of the intervals as a sorted slice of disjoint intervals.
+// Overlapping and touching intervals are combined and empty intervals are dropped.
+func MergeIntervals[T Ordered](intervals []Interval[T]) []Interval[T] {
+	sorted := Filter(intervals, func(_ int, iv Interval[T], _ interface{}) bool { return !iv.IsEmpty() }, nil)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	merged := make([]Interval[T], 0, len(sorted))
+	for _, iv := range sorted {
+		if last := len(merged) - 1; last >= 0 && !(merged[last].End < iv.Start) {
+			merged[last].End = maxOrdered(merged[last].End, iv.End)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// RangeSet is a set of values stored as sorted, disjoint, non-touching intervals.
+// The zero value is an empty set ready to use.
+type RangeSet[T Ordered] struct {
+	intervals []Interval[T]
+}
+
+// NewRangeSet returns a set holding the union of the intervals.
+func NewRangeSet[T Ordered](intervals ...Interval[T]) *RangeSet[T] {
+	return &RangeSet[T]{intervals: MergeIntervals(intervals)}
+}
+
+// Add adds the values of iv to the set, merging it with the intervals it overlaps or touches.
+func (rs *RangeSet[T]) Add(iv Interval[T]) {
+	if iv.IsEmpty() {
+		return
+	}
+
+	lo := sort.Search(len(rs.intervals), func(i int) bool { return !(rs.intervals[i].End < iv.Start) })
+	hi := sort.Search(len(rs.intervals), func(i int) bool { return iv.End < rs.intervals[i].Start })
+	if lo < hi {
+		iv.Start = minOrdered(iv.Start, rs.intervals[lo].Start)
+		iv.End = maxOrdered(iv.End, rs.intervals[hi-1].End)
+	}
+	rs.replace(lo, hi, iv)
+}
+
+// Complement returns the values within bounds that are not in the set.
+func (rs *RangeSet[T]) Complement(bounds Interval[T]) *RangeSet[T] {
+	result := &RangeSet[T]{}
+	if bounds.IsEmpty() {
+		return result
+	}
+
+	cursor := bounds.Start
+	for _, iv := range rs.intervals {
+		if !(cursor < bounds.End) {
+			break
+		}
+		if !(cursor < iv.End) {
+			continue
+		}
+		if cursor < iv.Start {
+			result.intervals = append(result.intervals, Interval[T]{Start: cursor, End: minOrdered(iv.Start, bounds.End)})
+		}
+		cursor = iv.End
+	}
+	if cursor < bounds.End {
+		result.intervals = append(result.intervals, Interval[T]{Start: cursor, End: bounds.End})
+	}
+	return result
+}
+
+// Contains reports whether value is in the set.
+func (rs *RangeSet[T]) Contains(value T) bool {
+	i := sort.Search(len(rs.intervals), func(i int) bool { return value < rs.intervals[i].End })
+	return i < len(rs.intervals) && rs.intervals[i].Contains(value)
+}
+
+// Difference returns the values of the set that are not in other.
+func (rs *RangeSet[T]) Difference(other *RangeSet[T]) *RangeSet[T] {
+	result := &RangeSet[T]{intervals: rs.Intervals()}
+	for _, iv := range other.intervals {
+		result.Remove(iv)
+	}
+	return result
+}
+
+// Intersection returns the values that are in both sets.
+func (rs *RangeSet[T]) Intersection(other *RangeSet[T]) *RangeSet[T] {
+	result := &RangeSet[T]{}
+	i, j := 0, 0
+	for i < len(rs.intervals) && j < len(other.intervals) {
+		a, b := rs.intervals[i], other.intervals[j]
+		if overlap, ok := a.Intersect(b); ok {
+			result.intervals = append(result.intervals, overlap)
+		}
+		if a.End < b.End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Intervals returns a copy of the disjoint intervals of the set in ascending order.
+func (rs *RangeSet[T]) Intervals() []Interval[T] {
+	return append([]Interval[T](nil), rs.intervals...)
+}
+
+// IsEmpty reports whether the set contains no values.
+func (rs *RangeSet[T]) IsEmpty() bool {
+	return len(rs.intervals) == 0
+}
+
+// Remove removes the values of iv from the set, splitting intervals that contain it.
+func (rs *RangeSet[T]) Remove(iv Interval[T]) {
+	if iv.IsEmpty() {
+		return
+	}
+
+	lo := sort.Search(len(rs.intervals), func(i int) bool { return iv.Start < rs.intervals[i].End })
+	hi := sort.Search(len(rs.intervals), func(i int) bool { return !(rs.intervals[i].Start < iv.End) })
+	if lo >= hi {
+		return
+	}
+
+	var pieces []Interval[T]
+	if first := rs.intervals[lo]; first.Start < iv.Start {
+		pieces = append(pieces, Interval[T]{Start: first.Start, End: iv.Start})
+	}
+	if last := rs.intervals[hi-1]; iv.End < last.End {
+		pieces = append(pieces, Interval[T]{Start: iv.End, End: last.End})
+	}
+	rs.replace(lo, hi, pieces...)
+}
+
+func (rs *RangeSet[T]) String() string {
+	return fmt.Sprint(rs.intervals)
+}
+
+// Union returns the values that are in either set.
+func (rs *RangeSet[T]) Union(other *RangeSet[T]) *RangeSet[T] {
+	return NewRangeSet(Concat(rs.intervals, other.intervals)...)
+}
+
+// replace replaces the intervals from index lo up to hi with the given intervals.
+func (rs *RangeSet[T]) replace(lo, hi int, intervals ...Interval[T]) {
+	tail := append(intervals, rs.intervals[hi:]...)
+	rs.intervals = append(rs.intervals[:lo], tail...)
+}
+
+func maxOrdered[T Ordered](a, b T) T {
+	if a < b {
+		return b
+	}
+	return a
+}
+
+func minOrdered[T Ordered](a, b T) T {
+	if b < a {
+		return b
+	}
+	return a
+}
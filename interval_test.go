@@ -0,0 +1,213 @@
+package fusion
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestInterval(t *testing.T) {
+	t.Parallel()
+
+	iv := NewInterval(2, 6)
+
+	testCases := []struct {
+		name         string
+		other        Interval[int]
+		overlaps     bool
+		intersection Interval[int]
+	}{
+		{name: "inside", other: NewInterval(3, 4), overlaps: true, intersection: NewInterval(3, 4)},
+		{name: "partial", other: NewInterval(5, 9), overlaps: true, intersection: NewInterval(5, 6)},
+		{name: "touching", other: NewInterval(6, 8), overlaps: false},
+		{name: "disjoint", other: NewInterval(-3, 0), overlaps: false},
+		{name: "empty", other: NewInterval(4, 4), overlaps: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if overlaps := iv.Overlaps(testCase.other); overlaps != testCase.overlaps {
+				t.Errorf("expected %v but got %v", testCase.overlaps, overlaps)
+			}
+			if intersection, _ := iv.Intersect(testCase.other); intersection != testCase.intersection {
+				t.Errorf("expected %v but got %v", testCase.intersection, intersection)
+			}
+		})
+	}
+
+	if !iv.Contains(2) || iv.Contains(6) || iv.Contains(1) {
+		t.Errorf("expected %v to contain its start but not its end", iv)
+	}
+	if !NewInterval(3, 3).IsEmpty() || !NewInterval(3, 1).IsEmpty() || iv.IsEmpty() {
+		t.Errorf("expected only intervals with End after Start to be non-empty")
+	}
+	if s := NewInterval(1.5, 2.5).String(); s != "[1.5, 2.5)" {
+		t.Errorf("expected [1.5, 2.5) but got %s", s)
+	}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []Interval[int]
+		expected []Interval[int]
+	}{
+		{
+			name:     "overlapping and touching",
+			input:    []Interval[int]{{8, 10}, {1, 3}, {2, 5}, {5, 6}, {12, 12}},
+			expected: []Interval[int]{{1, 6}, {8, 10}},
+		},
+		{
+			name:     "nested",
+			input:    []Interval[int]{{1, 10}, {2, 3}, {4, 5}},
+			expected: []Interval[int]{{1, 10}},
+		},
+		{
+			name:     "empty input",
+			input:    []Interval[int]{},
+			expected: []Interval[int]{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := MergeIntervals(testCase.input); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestRangeSet(t *testing.T) {
+	t.Parallel()
+
+	var rs RangeSet[int]
+	rs.Add(NewInterval(10, 20))
+	rs.Add(NewInterval(30, 40))
+	rs.Add(NewInterval(20, 25))
+	rs.Add(NewInterval(0, 0))
+	if expected := []Interval[int]{{10, 25}, {30, 40}}; !IsEqual(rs.Intervals(), expected) {
+		t.Errorf("expected %v but got %v", expected, rs.Intervals())
+	}
+
+	rs.Remove(NewInterval(15, 35))
+	if expected := []Interval[int]{{10, 15}, {35, 40}}; !IsEqual(rs.Intervals(), expected) {
+		t.Errorf("expected %v but got %v", expected, rs.Intervals())
+	}
+	rs.Remove(NewInterval(12, 13))
+	if expected := []Interval[int]{{10, 12}, {13, 15}, {35, 40}}; !IsEqual(rs.Intervals(), expected) {
+		t.Errorf("expected %v but got %v", expected, rs.Intervals())
+	}
+
+	if !rs.Contains(10) || rs.Contains(12) || !rs.Contains(14) || rs.Contains(15) || rs.Contains(40) {
+		t.Errorf("expected Contains to respect half-open bounds in %v", &rs)
+	}
+
+	complement := rs.Complement(NewInterval(0, 38))
+	if expected := []Interval[int]{{0, 10}, {12, 13}, {15, 35}}; !IsEqual(complement.Intervals(), expected) {
+		t.Errorf("expected %v but got %v", expected, complement.Intervals())
+	}
+	if !rs.Complement(NewInterval(5, 5)).IsEmpty() {
+		t.Errorf("expected the complement within empty bounds to be empty")
+	}
+}
+
+func TestRangeSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := NewRangeSet(NewInterval(0, 10), NewInterval(20, 30))
+	b := NewRangeSet(NewInterval(5, 25), NewInterval(40, 50))
+
+	testCases := []struct {
+		name     string
+		result   *RangeSet[int]
+		expected []Interval[int]
+	}{
+		{name: "Union", result: a.Union(b), expected: []Interval[int]{{0, 30}, {40, 50}}},
+		{name: "Intersection", result: a.Intersection(b), expected: []Interval[int]{{5, 10}, {20, 25}}},
+		{name: "Difference", result: a.Difference(b), expected: []Interval[int]{{0, 5}, {25, 30}}},
+		{name: "reverse Difference", result: b.Difference(a), expected: []Interval[int]{{10, 20}, {40, 50}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := testCase.result.Intervals(); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if expected := []Interval[int]{{0, 10}, {20, 30}}; !IsEqual(a.Intervals(), expected) {
+		t.Errorf("expected the operations to leave their operands unchanged but got %v", a.Intervals())
+	}
+}
+
+func TestRangeSetRandom(t *testing.T) {
+	t.Parallel()
+
+	const size = 100
+	rng := rand.New(rand.NewSource(1))
+	randomInterval := func() Interval[int] {
+		start := rng.Intn(size)
+		return NewInterval(start, start+rng.Intn(20))
+	}
+	toBits := func(rs *RangeSet[int]) [size + 20]bool {
+		var bits [size + 20]bool
+		for i := range bits {
+			bits[i] = rs.Contains(i)
+		}
+		return bits
+	}
+
+	var rs, other RangeSet[int]
+	var reference, otherReference [size + 20]bool
+	for step := 0; step < 2000; step++ {
+		iv := randomInterval()
+		add := rng.Intn(2) == 0
+		for i := iv.Start; i < iv.End; i++ {
+			reference[i] = add
+		}
+		if add {
+			rs.Add(iv)
+		} else {
+			rs.Remove(iv)
+		}
+
+		if toBits(&rs) != reference {
+			t.Fatalf("step %d: expected %v but got %v", step, reference, rs.Intervals())
+		}
+		for i := 1; i < len(rs.intervals); i++ {
+			if !(rs.intervals[i-1].End < rs.intervals[i].Start) {
+				t.Fatalf("step %d: expected disjoint, non-touching intervals but got %v", step, rs.Intervals())
+			}
+		}
+
+		if step%50 == 0 {
+			other.Add(randomInterval())
+			for i := range otherReference {
+				otherReference[i] = other.Contains(i)
+			}
+
+			var union, intersection, difference, complement [size + 20]bool
+			for i := range reference {
+				union[i] = reference[i] || otherReference[i]
+				intersection[i] = reference[i] && otherReference[i]
+				difference[i] = reference[i] && !otherReference[i]
+				complement[i] = !reference[i] && i >= 10 && i < 90
+			}
+			if toBits(rs.Union(&other)) != union {
+				t.Fatalf("step %d: unexpected Union", step)
+			}
+			if toBits(rs.Intersection(&other)) != intersection {
+				t.Fatalf("step %d: unexpected Intersection", step)
+			}
+			if toBits(rs.Difference(&other)) != difference {
+				t.Fatalf("step %d: unexpected Difference", step)
+			}
+			if toBits(rs.Complement(NewInterval(10, 90))) != complement {
+				t.Fatalf("step %d: unexpected Complement", step)
+			}
+		}
+	}
+}
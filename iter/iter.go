@@ -0,0 +1,176 @@
+//go:build go1.23
+
+// Package iter provides GoFusion operations on Go 1.23 iterators, iter.Seq and iter.Seq2.
+// Operations are lazy: they consume their input only as their result is ranged over.
+//
+// The package only builds with Go 1.23 or later; the rest of GoFusion keeps supporting older releases.
+package iter
+
+import (
+	"iter"
+)
+
+// Chunk returns a sequence of slices of size values from seq. The last slice holds the remaining
+// values and may be shorter. Each slice is newly allocated. If size is not positive, the sequence is empty.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		chunk := make([]T, 0, size)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Collect returns the values of seq as a slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+	for value := range seq {
+		result = append(result, value)
+	}
+	return result
+}
+
+// CollectMap returns the pairs of seq as a map. Later values replace earlier ones with the same key.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+	for key, value := range seq {
+		result[key] = value
+	}
+	return result
+}
+
+// Filter returns a sequence of the values of seq for which the predicate returns true.
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap returns a sequence of the key-value pairs of m, in no particular order.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range m {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// FromSlice returns a sequence of the elements of arr.
+func FromSlice[T any](arr []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range arr {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence of the keys of m, in no particular order.
+func Keys[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range m {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence of the results of applying fn to each value of seq.
+func Map[T any, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for value := range seq {
+			if !yield(fn(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the values of seq into a single value, starting from initialValue.
+func Reduce[T any, R any](seq iter.Seq[T], fn func(R, T) R, initialValue R) R {
+	result := initialValue
+	for value := range seq {
+		result = fn(result, value)
+	}
+	return result
+}
+
+// Take returns a sequence of the first n values of seq. It stops reading seq after the n-th value.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for value := range seq {
+			if !yield(value) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Uniq returns a sequence of the values of seq that have not been seen before.
+func Uniq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for value := range seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values of m, in no particular order.
+func Values[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns a sequence of pairs of values taken from a and b in step, ending with the shorter sequence.
+func Zip[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for valueA := range a {
+			valueB, ok := nextB()
+			if !ok || !yield(valueA, valueB) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,269 @@
+//go:build go1.23
+
+package iter
+
+import (
+	"iter"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+// naturals yields 0, 1, 2, ... and never ends on its own.
+func naturals(yield func(int) bool) {
+	for i := 0; yield(i); i++ {
+	}
+}
+
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{name: "even", input: []int{1, 2, 3, 4}, size: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		{name: "remainder", input: []int{1, 2, 3, 4, 5}, size: 2, expected: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "larger than input", input: []int{1, 2}, size: 5, expected: [][]int{{1, 2}}},
+		{name: "empty input", input: []int{}, size: 2, expected: [][]int{}},
+		{name: "zero size", input: []int{1, 2}, size: 0, expected: [][]int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Collect(Chunk(FromSlice(testCase.input), testCase.size))
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	// Chunks must not share storage, so keeping one is safe.
+	chunks := Collect(Chunk(Take(naturals, 6), 3))
+	chunks[0] = append(chunks[0], 99)
+	if expected := []int{3, 4, 5}; !reflect.DeepEqual(chunks[1], expected) {
+		t.Errorf("expected %v but got %v", expected, chunks[1])
+	}
+}
+
+func TestCollectMap(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]int{"a": 1, "b": 2}
+	if result := CollectMap(FromMap(input)); !reflect.DeepEqual(result, input) {
+		t.Errorf("expected %v but got %v", input, result)
+	}
+
+	zipped := CollectMap(Zip(FromSlice([]string{"a", "b", "a"}), FromSlice([]int{1, 2, 3})))
+	if expected := map[string]int{"a": 3, "b": 2}; !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("expected %v but got %v", expected, zipped)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	testCases := []struct {
+		name     string
+		input    iter.Seq[int]
+		expected []string
+	}{
+		{name: "slice", input: FromSlice([]int{1, 2, 3, 4, 5, 6}), expected: []string{"2", "4", "6"}},
+		{name: "infinite", input: naturals, expected: []string{"0", "2", "4"}},
+		{name: "empty", input: FromSlice([]int(nil)), expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Collect(Take(Map(Filter(testCase.input, isEven), strconv.Itoa), 3))
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestKeysValues(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	keys := Collect(Keys(m))
+	sort.Strings(keys)
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v but got %v", expected, keys)
+	}
+
+	values := Collect(Values(m))
+	sort.Ints(values)
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v but got %v", expected, values)
+	}
+
+	if result := Collect(Take(Keys(m), 2)); len(result) != 2 {
+		t.Errorf("expected 2 keys but got %v", result)
+	}
+}
+
+func TestRangeOverFunc(t *testing.T) {
+	t.Parallel()
+
+	var result []int
+	for value := range Map(FromSlice([]int{1, 2, 3, 4}), func(v int) int { return v * 10 }) {
+		if value > 30 {
+			break
+		}
+		result = append(result, value)
+	}
+	if expected := []int{10, 20, 30}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected string
+	}{
+		{name: "values", input: []int{1, 2, 3}, expected: "start-1-2-3"},
+		{name: "empty", input: []int{}, expected: "start"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Reduce(FromSlice(testCase.input), func(acc string, v int) string {
+				return acc + "-" + strconv.Itoa(v)
+			}, "start")
+			if result != testCase.expected {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    iter.Seq[int]
+		n        int
+		expected []int
+	}{
+		{name: "fewer than input", input: FromSlice([]int{1, 2, 3}), n: 2, expected: []int{1, 2}},
+		{name: "more than input", input: FromSlice([]int{1, 2, 3}), n: 5, expected: []int{1, 2, 3}},
+		{name: "infinite", input: naturals, n: 4, expected: []int{0, 1, 2, 3}},
+		{name: "zero", input: naturals, n: 0, expected: []int{}},
+		{name: "negative", input: naturals, n: -1, expected: []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Collect(Take(testCase.input, testCase.n)); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	// Take stops pulling from its input once it has n values.
+	pulled := 0
+	counted := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	Collect(Take(counted, 3))
+	if pulled != 3 {
+		t.Errorf("expected 3 values to be pulled but got %d", pulled)
+	}
+}
+
+func TestUniq(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{name: "duplicates", input: []string{"a", "b", "a", "c", "b"}, expected: []string{"a", "b", "c"}},
+		{name: "no duplicates", input: []string{"x", "y"}, expected: []string{"x", "y"}},
+		{name: "empty", input: []string{}, expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Collect(Uniq(FromSlice(testCase.input))); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if result := Collect(Take(Uniq(Map(naturals, func(v int) int { return v % 3 })), 3)); !reflect.DeepEqual(result, []int{0, 1, 2}) {
+		t.Errorf("expected [0 1 2] but got %v", result)
+	}
+}
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	type pair struct {
+		a int
+		b string
+	}
+
+	testCases := []struct {
+		name     string
+		a        iter.Seq[int]
+		b        iter.Seq[string]
+		expected []pair
+	}{
+		{
+			name:     "same length",
+			a:        FromSlice([]int{1, 2}),
+			b:        FromSlice([]string{"x", "y"}),
+			expected: []pair{{1, "x"}, {2, "y"}},
+		},
+		{
+			name:     "shorter first",
+			a:        FromSlice([]int{1}),
+			b:        FromSlice([]string{"x", "y"}),
+			expected: []pair{{1, "x"}},
+		},
+		{
+			name:     "shorter second",
+			a:        naturals,
+			b:        FromSlice([]string{"x", "y", "z"}),
+			expected: []pair{{0, "x"}, {1, "y"}, {2, "z"}},
+		},
+		{
+			name:     "empty",
+			a:        FromSlice([]int{}),
+			b:        FromSlice([]string{"x"}),
+			expected: []pair{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := make([]pair, 0)
+			for a, b := range Zip(testCase.a, testCase.b) {
+				result = append(result, pair{a, b})
+			}
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
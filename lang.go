@@ -0,0 +1,14 @@
+package fusion
+
+import "fmt"
+
+// ToString converts a value to its string form using the default fmt formatting (%v).
+// It is the formatting used by Join and by string helpers that interpolate values.
+func ToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
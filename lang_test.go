@@ -0,0 +1,55 @@
+package fusion
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{
+			name:     "string",
+			input:    "hello",
+			expected: "hello",
+		},
+		{
+			name:     "integer",
+			input:    42,
+			expected: "42",
+		},
+		{
+			name:     "float",
+			input:    3.5,
+			expected: "3.5",
+		},
+		{
+			name:     "nil",
+			input:    nil,
+			expected: "<nil>",
+		},
+		{
+			name:     "error",
+			input:    errors.New("boom"),
+			expected: "boom",
+		},
+		{
+			name:     "slice",
+			input:    []int{1, 2},
+			expected: "[1 2]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := ToString(testCase.input); result != testCase.expected {
+				t.Errorf("expected %q but got %q", testCase.expected, result)
+			}
+		})
+	}
+}
@@ -1,5 +1,61 @@
 package fusion
 
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IndexStyle selects how FlattenMapWith writes slice indexes into keys and how UnflattenMapWith reads them.
+type IndexStyle int
+
+const (
+	// IndexDot writes slice indexes like map keys, as in "list.0".
+	IndexDot IndexStyle = iota
+	// IndexBrackets writes slice indexes in brackets, as in "list[0]".
+	IndexBrackets
+)
+
+// FlattenOptions configures FlattenMapWith and UnflattenMapWith.
+type FlattenOptions struct {
+	// Separator joins the keys of nested maps. It defaults to ".".
+	Separator string
+	// IndexStyle selects how slice indexes appear in keys.
+	IndexStyle IndexStyle
+	// MaxDepth, if positive, is the largest number of path segments in a flattened key.
+	// Values nested deeper are stored unchanged under the key of their parent.
+	MaxDepth int
+}
+
+// KeyCollisionError is returned when several values would be stored under the same key.
+type KeyCollisionError struct {
+	// Keys lists the colliding keys in sorted order.
+	Keys []string
+}
+
+func (e *KeyCollisionError) Error() string {
+	return fmt.Sprintf("fusion: colliding keys: %s", strings.Join(e.Keys, ", "))
+}
+
+// FlattenMap converts nested maps and slices into a single-level map whose keys are paths joined by sep,
+// such as {"a.b.c": 1, "list.0": "x"}. Empty nested maps and slices are kept as values.
+// It returns a *KeyCollisionError if two paths produce the same key.
+func FlattenMap(m map[string]interface{}, sep string) (map[string]interface{}, error) {
+	return FlattenMapWith(m, FlattenOptions{Separator: sep})
+}
+
+// FlattenMapWith is like FlattenMap, but is configured by opts.
+func FlattenMapWith(m map[string]interface{}, opts FlattenOptions) (map[string]interface{}, error) {
+	f := flattener{opts: opts.withDefaults(), result: make(map[string]interface{})}
+	f.flatten("", reflect.ValueOf(m), 0)
+	if len(f.collisions) > 0 {
+		return f.result, &KeyCollisionError{Keys: Uniq(f.sortedCollisions())}
+	}
+	return f.result, nil
+}
+
 // GetOrDefault returns the value if found, otherwise returns the provided default value
 func GetOrDefault[T comparable, V any](m map[T]V, key T, defaultValue V) V {
 	value, ok := m[key]
@@ -18,6 +74,19 @@ func Keys[T comparable, U any](m map[T]U) []T {
 	return keys
 }
 
+// KeysWithPrefix returns the keys of the given map that start with prefix, in sorted order.
+// It scans every key; use a Trie for repeated prefix lookups.
+func KeysWithPrefix[V any](m map[string]V, prefix string) []string {
+	keys := make([]string, 0)
+	for key := range m {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // MapKeys applies the given function to each key-value pair in the map and returns a slice
 // containing the results of the function applied to each key.
 func MapKeys[K comparable, V any, R any](m map[K]V, fn func(K, V) R) []R {
@@ -78,6 +147,39 @@ func Pick[K comparable, V any](input map[K]V, keys ...K) map[K]V {
 	return result
 }
 
+// UnflattenMap is the inverse of FlattenMap: it splits keys on sep and rebuilds the nested maps.
+// Maps whose keys are exactly the indexes 0 to n-1 become slices.
+// It returns a *KeyCollisionError if a key is both a value and the parent of other keys.
+func UnflattenMap(m map[string]interface{}, sep string) (map[string]interface{}, error) {
+	return UnflattenMapWith(m, FlattenOptions{Separator: sep})
+}
+
+// UnflattenMapWith is like UnflattenMap, but is configured by opts.
+// With IndexBrackets, only bracketed segments such as "list[0]" become slice indexes.
+func UnflattenMapWith(m map[string]interface{}, opts FlattenOptions) (map[string]interface{}, error) {
+	opts = opts.withDefaults()
+
+	keys := Keys(m)
+	sort.Strings(keys)
+
+	root := &unflattenNode{}
+	var collisions []string
+	for _, key := range keys {
+		if !root.insert(splitFlatKey(key, opts), m[key]) {
+			collisions = append(collisions, key)
+		}
+	}
+
+	result := make(map[string]interface{}, len(root.children))
+	for key, child := range root.children {
+		result[key] = child.build(opts)
+	}
+	if len(collisions) > 0 {
+		return result, &KeyCollisionError{Keys: collisions}
+	}
+	return result, nil
+}
+
 // Values returns a slice containing all the values from the given map.
 func Values[T comparable, U any](m map[T]U) []U {
 	values := make([]U, 0, len(m))
@@ -86,3 +188,185 @@ func Values[T comparable, U any](m map[T]U) []U {
 	}
 	return values
 }
+
+func (opts FlattenOptions) withDefaults() FlattenOptions {
+	if opts.Separator == "" {
+		opts.Separator = "."
+	}
+	return opts
+}
+
+type flattener struct {
+	opts       FlattenOptions
+	result     map[string]interface{}
+	collisions []string
+}
+
+func (f *flattener) flatten(prefix string, v reflect.Value, depth int) {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	canDescend := f.opts.MaxDepth <= 0 || depth < f.opts.MaxDepth
+	switch {
+	case canDescend && v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String && v.Len() > 0:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			f.flatten(f.join(prefix, key.String(), depth), v.MapIndex(key), depth+1)
+		}
+	case canDescend && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) &&
+		v.Type().Elem().Kind() != reflect.Uint8 && v.Len() > 0 && depth > 0:
+		for i := 0; i < v.Len(); i++ {
+			f.flatten(f.index(prefix, i, depth), v.Index(i), depth+1)
+		}
+	case depth > 0:
+		// Empty keys are kept too, so the root is told apart by its depth rather than its prefix.
+		if _, exists := f.result[prefix]; exists {
+			f.collisions = append(f.collisions, prefix)
+		}
+		if v.IsValid() {
+			f.result[prefix] = v.Interface()
+		} else {
+			f.result[prefix] = nil
+		}
+	}
+}
+
+func (f *flattener) join(prefix, key string, depth int) string {
+	if depth == 0 {
+		return key
+	}
+	return prefix + f.opts.Separator + key
+}
+
+func (f *flattener) index(prefix string, i int, depth int) string {
+	if f.opts.IndexStyle == IndexBrackets {
+		return prefix + "[" + strconv.Itoa(i) + "]"
+	}
+	return f.join(prefix, strconv.Itoa(i), depth)
+}
+
+func (f *flattener) sortedCollisions() []string {
+	sort.Strings(f.collisions)
+	return f.collisions
+}
+
+// flatKeySegment is one step of a flattened key: a map key, or a slice index written in brackets.
+type flatKeySegment struct {
+	key     string
+	bracket bool
+}
+
+func splitFlatKey(key string, opts FlattenOptions) []flatKeySegment {
+	var segments []flatKeySegment
+	for _, part := range strings.Split(key, opts.Separator) {
+		if opts.IndexStyle != IndexBrackets {
+			segments = append(segments, flatKeySegment{key: part})
+			continue
+		}
+		name := part
+		var indexes []string
+		for strings.HasSuffix(name, "]") {
+			open := strings.LastIndex(name, "[")
+			if open < 0 {
+				break
+			}
+			if _, err := strconv.Atoi(name[open+1 : len(name)-1]); err != nil {
+				break
+			}
+			indexes = append([]string{name[open+1 : len(name)-1]}, indexes...)
+			name = name[:open]
+		}
+		if name != "" || len(indexes) == 0 {
+			segments = append(segments, flatKeySegment{key: name})
+		}
+		for _, index := range indexes {
+			segments = append(segments, flatKeySegment{key: index, bracket: true})
+		}
+	}
+	return segments
+}
+
+type unflattenNode struct {
+	children map[string]*unflattenNode
+	bracket  bool
+	value    interface{}
+	leaf     bool
+}
+
+// insert stores value at the path, and reports false if the path collides with an existing value.
+func (n *unflattenNode) insert(path []flatKeySegment, value interface{}) bool {
+	node := n
+	for _, segment := range path {
+		if node.leaf {
+			return false
+		}
+		if node.children == nil {
+			node.children = make(map[string]*unflattenNode)
+		}
+		child, ok := node.children[segment.key]
+		if !ok {
+			child = &unflattenNode{bracket: segment.bracket}
+			node.children[segment.key] = child
+		}
+		node = child
+	}
+	if node.leaf || node.children != nil {
+		return false
+	}
+	node.value, node.leaf = value, true
+	return true
+}
+
+func (n *unflattenNode) build(opts FlattenOptions) interface{} {
+	if n.leaf {
+		return n.value
+	}
+
+	if items, ok := n.buildSlice(opts); ok {
+		return items
+	}
+	result := make(map[string]interface{}, len(n.children))
+	for key, child := range n.children {
+		result[key] = child.build(opts)
+	}
+	return result
+}
+
+// buildSlice converts the children of n into a slice if their keys are slice indexes.
+// With IndexBrackets, bracketed indexes form a slice, leaving nil for any gaps, unless the largest index
+// is at least twice the number of children, which keeps untrusted keys from allocating huge slices;
+// otherwise the keys must be exactly 0 to n-1.
+func (n *unflattenNode) buildSlice(opts FlattenOptions) ([]interface{}, bool) {
+	if len(n.children) == 0 {
+		return nil, false
+	}
+
+	max := -1
+	for key, child := range n.children {
+		if opts.IndexStyle == IndexBrackets && !child.bracket {
+			return nil, false
+		}
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || strconv.Itoa(i) != key {
+			return nil, false
+		}
+		if i > max {
+			max = i
+		}
+	}
+	if opts.IndexStyle != IndexBrackets && max != len(n.children)-1 {
+		return nil, false
+	}
+	if max >= 2*len(n.children) {
+		return nil, false
+	}
+
+	items := make([]interface{}, max+1)
+	for key, child := range n.children {
+		i, _ := strconv.Atoi(key)
+		items[i] = child.build(opts)
+	}
+	return items, true
+}
@@ -1,12 +1,150 @@
 package fusion
 
 import (
+	"errors"
 	"reflect"
 	"sort"
 	"strconv"
 	"testing"
 )
 
+func TestFlattenMap(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": map[string]interface{}{"c": 1},
+			"d": "x",
+		},
+		"list":  []interface{}{"x", map[string]interface{}{"name": "y"}},
+		"empty": map[string]interface{}{},
+		"typed": map[string]int{"n": 2},
+		"bytes": []byte("raw"),
+	}
+
+	testCases := []struct {
+		name     string
+		opts     FlattenOptions
+		expected map[string]interface{}
+	}{
+		{
+			name: "dot index style",
+			opts: FlattenOptions{},
+			expected: map[string]interface{}{
+				"a.b.c":       1,
+				"a.d":         "x",
+				"list.0":      "x",
+				"list.1.name": "y",
+				"empty":       map[string]interface{}{},
+				"typed.n":     2,
+				"bytes":       []byte("raw"),
+			},
+		},
+		{
+			name: "bracket index style with custom separator",
+			opts: FlattenOptions{Separator: "__", IndexStyle: IndexBrackets},
+			expected: map[string]interface{}{
+				"a__b__c":       1,
+				"a__d":          "x",
+				"list[0]":       "x",
+				"list[1]__name": "y",
+				"empty":         map[string]interface{}{},
+				"typed__n":      2,
+				"bytes":         []byte("raw"),
+			},
+		},
+		{
+			name: "max depth",
+			opts: FlattenOptions{MaxDepth: 2},
+			expected: map[string]interface{}{
+				"a.b":     map[string]interface{}{"c": 1},
+				"a.d":     "x",
+				"list.0":  "x",
+				"list.1":  map[string]interface{}{"name": "y"},
+				"empty":   map[string]interface{}{},
+				"typed.n": 2,
+				"bytes":   []byte("raw"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := FlattenMapWith(input, testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	result, err := FlattenMap(map[string]interface{}{"x": map[string]interface{}{"y": true}}, "/")
+	if err != nil || !reflect.DeepEqual(result, map[string]interface{}{"x/y": true}) {
+		t.Errorf("FlattenMap: unexpected result %v (%v)", result, err)
+	}
+}
+
+func TestFlattenMapCollisions(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"a.b": 1,
+		"a":   map[string]interface{}{"b": 2, "c": 3},
+	}
+
+	result, err := FlattenMap(input, ".")
+	var collision *KeyCollisionError
+	if !errors.As(err, &collision) {
+		t.Fatalf("expected a *KeyCollisionError but got %v", err)
+	}
+	if !reflect.DeepEqual(collision.Keys, []string{"a.b"}) {
+		t.Errorf("expected the colliding key a.b but got %v", collision.Keys)
+	}
+	if result["a.c"] != 3 {
+		t.Errorf("expected the other keys to be flattened but got %v", result)
+	}
+}
+
+func TestFlattenMapEmptyKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "top-level empty key",
+			input:    map[string]interface{}{"": 1, "a": 2},
+			expected: map[string]interface{}{"": 1, "a": 2},
+		},
+		{
+			name:     "nested under empty key",
+			input:    map[string]interface{}{"": map[string]interface{}{"a": 1, "": []interface{}{"x"}}},
+			expected: map[string]interface{}{".a": 1, "..0": "x"},
+		},
+		{
+			name:     "empty key inside map",
+			input:    map[string]interface{}{"n": map[string]interface{}{"": 2}},
+			expected: map[string]interface{}{"n.": 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := FlattenMap(testCase.input, ".")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
 func TestGetOrDefault(t *testing.T) {
 	t.Parallel()
 
@@ -95,6 +233,43 @@ func TestKeys(t *testing.T) {
 	}
 }
 
+func TestKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]int{"car": 1, "cart": 2, "care": 3, "dog": 4, "": 5}
+
+	testCases := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{
+			name:     "matching prefix",
+			prefix:   "car",
+			expected: []string{"car", "care", "cart"},
+		},
+		{
+			name:     "empty prefix",
+			prefix:   "",
+			expected: []string{"", "car", "care", "cart", "dog"},
+		},
+		{
+			name:     "no match",
+			prefix:   "cat",
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := KeysWithPrefix(input, testCase.prefix)
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
 func TestMapKeys(t *testing.T) {
 	t.Parallel()
 
@@ -331,6 +506,106 @@ func TestPick(t *testing.T) {
 	}
 }
 
+func TestUnflattenMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    map[string]interface{}
+		opts     FlattenOptions
+		expected map[string]interface{}
+	}{
+		{
+			name:  "dot index style",
+			input: map[string]interface{}{"a.b.c": 1, "a.d": "x", "list.0": "x", "list.1.name": "y", "gaps.0": 1, "gaps.2": 2},
+			opts:  FlattenOptions{},
+			expected: map[string]interface{}{
+				"a":    map[string]interface{}{"b": map[string]interface{}{"c": 1}, "d": "x"},
+				"list": []interface{}{"x", map[string]interface{}{"name": "y"}},
+				"gaps": map[string]interface{}{"0": 1, "2": 2},
+			},
+		},
+		{
+			name:  "bracket index style",
+			input: map[string]interface{}{"list[0]": "x", "list[1].name": "y", "matrix[0][1]": 5, "ids.0": "a"},
+			opts:  FlattenOptions{IndexStyle: IndexBrackets},
+			expected: map[string]interface{}{
+				"list":   []interface{}{"x", map[string]interface{}{"name": "y"}},
+				"matrix": []interface{}{[]interface{}{nil, 5}},
+				"ids":    map[string]interface{}{"0": "a"},
+			},
+		},
+		{
+			name:  "sparse bracket indexes stay in a map",
+			input: map[string]interface{}{"a[99999999999999]": 1, "b[0]": "x", "b[5]": "y"},
+			opts:  FlattenOptions{IndexStyle: IndexBrackets},
+			expected: map[string]interface{}{
+				"a": map[string]interface{}{"99999999999999": 1},
+				"b": map[string]interface{}{"0": "x", "5": "y"},
+			},
+		},
+		{
+			name:     "top-level numeric keys stay in a map",
+			input:    map[string]interface{}{"0": "a", "1": "b"},
+			opts:     FlattenOptions{},
+			expected: map[string]interface{}{"0": "a", "1": "b"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := UnflattenMapWith(testCase.input, testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestUnflattenMapCollisions(t *testing.T) {
+	t.Parallel()
+
+	_, err := UnflattenMap(map[string]interface{}{"a": 1, "a.b": 2, "c.d": 3, "c.d.e": 4}, ".")
+	var collision *KeyCollisionError
+	if !errors.As(err, &collision) {
+		t.Fatalf("expected a *KeyCollisionError but got %v", err)
+	}
+	if !reflect.DeepEqual(collision.Keys, []string{"a.b", "c.d.e"}) {
+		t.Errorf("expected the colliding keys [a.b c.d.e] but got %v", collision.Keys)
+	}
+}
+
+func TestFlattenUnflattenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := map[string]interface{}{
+		"server": map[string]interface{}{
+			"hosts": []interface{}{"a", "b"},
+			"port":  8080,
+			"tls":   map[string]interface{}{"enabled": true},
+		},
+		"name": "svc",
+	}
+
+	for _, style := range []IndexStyle{IndexDot, IndexBrackets} {
+		opts := FlattenOptions{Separator: "_", IndexStyle: style}
+		flat, err := FlattenMapWith(original, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		restored, err := UnflattenMapWith(flat, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(restored, original) {
+			t.Errorf("style %d: expected %v but got %v", style, original, restored)
+		}
+	}
+}
+
 func TestValues(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,276 @@
+package fusion
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrOverflow is returned when an integer computation does not fit in its type.
+var ErrOverflow = errors.New("fusion: integer overflow")
+
+// PercentileMethod selects how Percentile interpolates between two data points.
+type PercentileMethod int
+
+const (
+	// PercentileLinear interpolates linearly between the two closest data points.
+	PercentileLinear PercentileMethod = iota
+	// PercentileLower takes the lower of the two closest data points.
+	PercentileLower
+	// PercentileHigher takes the higher of the two closest data points.
+	PercentileHigher
+	// PercentileNearest takes the closest data point, rounding half to even.
+	PercentileNearest
+	// PercentileMidpoint takes the average of the two closest data points.
+	PercentileMidpoint
+)
+
+// compensatedSum accumulates values using Neumaier's variant of Kahan summation,
+// which keeps the rounding error of floating-point additions in a separate term.
+// For integer types the compensation term is always zero, and add panics with ErrOverflow
+// if the sum does not fit in the type.
+type compensatedSum[T Number] struct {
+	sum          T
+	compensation T
+}
+
+func (s *compensatedSum[T]) add(value T) {
+	total := s.sum + value
+	// Adding a positive value never decreases a float sum, so this only triggers on integer wraparound.
+	if (value > 0 && total < s.sum) || (value < 0 && total > s.sum) {
+		panic(ErrOverflow)
+	}
+	// Once the sum is infinite or NaN the compensation would only turn into NaN.
+	if f := float64(total); !math.IsInf(f, 0) && !math.IsNaN(f) {
+		if abs(s.sum) >= abs(value) {
+			s.compensation += (s.sum - total) + value
+		} else {
+			s.compensation += (value - total) + s.sum
+		}
+	}
+	s.sum = total
+}
+
+func (s *compensatedSum[T]) result() T {
+	return s.sum + s.compensation
+}
+
+func abs[T Number](value T) T {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// Max returns the largest element of the slice, or the zero value if the slice is empty.
+func Max[T Ordered](arr []T) T {
+	_, max := MinMax(arr)
+	return max
+}
+
+// MaxBy returns the element of the slice for which the key function returns the largest value.
+// The first such element wins ties. It returns the zero value if the slice is empty.
+func MaxBy[T any, K Ordered](arr []T, key func(T) K) T {
+	var result T
+	var best K
+	for i, value := range arr {
+		if k := key(value); i == 0 || k > best {
+			result, best = value, k
+		}
+	}
+	return result
+}
+
+// Mean returns the arithmetic mean of the slice, or NaN if the slice is empty.
+// Values are accumulated as compensated float64 sums, so integer inputs cannot overflow.
+func Mean[T Number](arr []T) float64 {
+	return MeanBy(arr, func(value T) T { return value })
+}
+
+// MeanBy returns the arithmetic mean of the values produced by fn for each element,
+// or NaN if the slice is empty.
+func MeanBy[T any, N Number](arr []T, fn func(T) N) float64 {
+	if len(arr) == 0 {
+		return math.NaN()
+	}
+	var sum compensatedSum[float64]
+	for _, value := range arr {
+		sum.add(float64(fn(value)))
+	}
+	return sum.result() / float64(len(arr))
+}
+
+// Median returns the middle value of the slice, averaging the two middle values for an even length,
+// or NaN if the slice is empty.
+func Median[T Number](arr []T) float64 {
+	return Percentile(arr, 50, PercentileLinear)
+}
+
+// Min returns the smallest element of the slice, or the zero value if the slice is empty.
+func Min[T Ordered](arr []T) T {
+	min, _ := MinMax(arr)
+	return min
+}
+
+// MinBy returns the element of the slice for which the key function returns the smallest value.
+// The first such element wins ties. It returns the zero value if the slice is empty.
+func MinBy[T any, K Ordered](arr []T, key func(T) K) T {
+	var result T
+	var best K
+	for i, value := range arr {
+		if k := key(value); i == 0 || k < best {
+			result, best = value, k
+		}
+	}
+	return result
+}
+
+// MinMax returns the smallest and the largest elements of the slice in a single pass,
+// or zero values if the slice is empty.
+func MinMax[T Ordered](arr []T) (T, T) {
+	var min, max T
+	for i, value := range arr {
+		if i == 0 || value < min {
+			min = value
+		}
+		if i == 0 || value > max {
+			max = value
+		}
+	}
+	return min, max
+}
+
+// Mode returns the most frequent values of the slice in the order of their first occurrence.
+// It returns more than one value when several values share the highest frequency.
+func Mode[T comparable](arr []T) []T {
+	counts := make(map[T]int)
+	best := 0
+	for _, value := range arr {
+		counts[value]++
+		if counts[value] > best {
+			best = counts[value]
+		}
+	}
+
+	var modes []T
+	for _, value := range arr {
+		if counts[value] == best {
+			modes = append(modes, value)
+			counts[value] = 0
+		}
+	}
+	return modes
+}
+
+// Percentile returns the p-th percentile of the slice, where p is between 0 and 100.
+// When the percentile falls between two data points, method selects how they are combined.
+// It returns NaN if the slice is empty or p is out of range.
+func Percentile[T Number](arr []T, p float64, method PercentileMethod) float64 {
+	if len(arr) == 0 || math.IsNaN(p) || p < 0 || p > 100 {
+		return math.NaN()
+	}
+
+	sorted := make([]float64, len(arr))
+	for i, value := range arr {
+		sorted[i] = float64(value)
+	}
+	sort.Float64s(sorted)
+
+	position := float64(len(sorted)-1) * p / 100
+	lower := sorted[int(math.Floor(position))]
+	upper := sorted[int(math.Ceil(position))]
+
+	switch method {
+	case PercentileLower:
+		return lower
+	case PercentileHigher:
+		return upper
+	case PercentileNearest:
+		return sorted[int(math.RoundToEven(position))]
+	case PercentileMidpoint:
+		return (lower + upper) / 2
+	default:
+		return lower + (upper-lower)*(position-math.Floor(position))
+	}
+}
+
+// Product returns the product of the elements of the slice, or 1 if the slice is empty.
+func Product[T Number](arr []T) T {
+	product := T(1)
+	for _, value := range arr {
+		product *= value
+	}
+	return product
+}
+
+// SampleStdDev returns the sample standard deviation of the slice, or NaN if it has fewer than two elements.
+func SampleStdDev[T Number](arr []T) float64 {
+	return math.Sqrt(SampleVariance(arr))
+}
+
+// SampleVariance returns the sample variance of the slice, using n-1 as the divisor,
+// or NaN if it has fewer than two elements.
+func SampleVariance[T Number](arr []T) float64 {
+	if len(arr) < 2 {
+		return math.NaN()
+	}
+	return sumSquaredDeviations(arr) / float64(len(arr)-1)
+}
+
+// StdDev returns the population standard deviation of the slice, or NaN if the slice is empty.
+func StdDev[T Number](arr []T) float64 {
+	return math.Sqrt(Variance(arr))
+}
+
+// Sum returns the sum of the elements of the slice.
+// Floating-point values are added with Kahan summation to limit rounding errors.
+// It panics with ErrOverflow if an integer sum does not fit in the element type; use SumChecked
+// to get the error instead.
+func Sum[T Number](arr []T) T {
+	return SumBy(arr, func(value T) T { return value })
+}
+
+// SumBy returns the sum of the values produced by fn for each element of the slice.
+// Like Sum, it panics with ErrOverflow if an integer sum does not fit in the result type.
+func SumBy[T any, N Number](arr []T, fn func(T) N) N {
+	var sum compensatedSum[N]
+	for _, value := range arr {
+		sum.add(fn(value))
+	}
+	return sum.result()
+}
+
+// SumChecked returns the sum of the elements of the slice, or ErrOverflow if the sum
+// does not fit in the element type.
+func SumChecked[T Integer](arr []T) (T, error) {
+	var sum T
+	for _, value := range arr {
+		next := sum + value
+		if (value > 0 && next < sum) || (value < 0 && next > sum) {
+			return 0, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// Variance returns the population variance of the slice, or NaN if the slice is empty.
+func Variance[T Number](arr []T) float64 {
+	if len(arr) == 0 {
+		return math.NaN()
+	}
+	return sumSquaredDeviations(arr) / float64(len(arr))
+}
+
+// sumSquaredDeviations returns the sum of squared deviations from the mean,
+// computed with Welford's online algorithm in float64 for numerical stability.
+func sumSquaredDeviations[T Number](arr []T) float64 {
+	mean, m2 := 0.0, 0.0
+	for i, value := range arr {
+		x := float64(value)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2
+}
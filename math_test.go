@@ -0,0 +1,434 @@
+package fusion
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) <= 1e-9
+}
+
+func TestMaxMin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       []int
+		expectedMin int
+		expectedMax int
+	}{
+		{
+			name:        "mixed values",
+			input:       []int{3, -2, 9, 4},
+			expectedMin: -2,
+			expectedMax: 9,
+		},
+		{
+			name:        "single value",
+			input:       []int{5},
+			expectedMin: 5,
+			expectedMax: 5,
+		},
+		{
+			name:        "empty slice",
+			input:       []int{},
+			expectedMin: 0,
+			expectedMax: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Min(testCase.input); result != testCase.expectedMin {
+				t.Errorf("Min: expected %d but got %d", testCase.expectedMin, result)
+			}
+			if result := Max(testCase.input); result != testCase.expectedMax {
+				t.Errorf("Max: expected %d but got %d", testCase.expectedMax, result)
+			}
+			min, max := MinMax(testCase.input)
+			if min != testCase.expectedMin || max != testCase.expectedMax {
+				t.Errorf("MinMax: expected (%d, %d) but got (%d, %d)", testCase.expectedMin, testCase.expectedMax, min, max)
+			}
+		})
+	}
+
+	if result := Max([]string{"pear", "apple", "zucchini"}); result != "zucchini" {
+		t.Errorf("Max: expected zucchini but got %s", result)
+	}
+}
+
+func TestMaxByMinBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"ann", 31}, {"bob", 25}, {"cid", 31}, {"dan", 25}}
+	age := func(u user) int { return u.age }
+
+	if result := MaxBy(users, age); result.name != "ann" {
+		t.Errorf("MaxBy: expected ann but got %s", result.name)
+	}
+	if result := MinBy(users, age); result.name != "bob" {
+		t.Errorf("MinBy: expected bob but got %s", result.name)
+	}
+	if result := MaxBy([]user{}, age); result != (user{}) {
+		t.Errorf("MaxBy: expected the zero value but got %v", result)
+	}
+}
+
+func TestMean(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int64
+		expected float64
+	}{
+		{
+			name:     "normal case",
+			input:    []int64{1, 2, 3, 4},
+			expected: 2.5,
+		},
+		{
+			name:     "values whose sum overflows int64",
+			input:    []int64{math.MaxInt64, math.MaxInt64},
+			expected: math.MaxInt64,
+		},
+		{
+			name:     "empty slice",
+			input:    []int64{},
+			expected: math.NaN(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Mean(testCase.input); !almostEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	words := []string{"a", "abc", "ab"}
+	if result := MeanBy(words, func(s string) int { return len(s) }); result != 2 {
+		t.Errorf("MeanBy: expected 2 but got %v", result)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []float64
+		expected float64
+	}{
+		{
+			name:     "odd length",
+			input:    []float64{5, 1, 3},
+			expected: 3,
+		},
+		{
+			name:     "even length",
+			input:    []float64{4, 1, 3, 2},
+			expected: 2.5,
+		},
+		{
+			name:     "empty slice",
+			input:    nil,
+			expected: math.NaN(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Median(testCase.input); !almostEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestMode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "single mode",
+			input:    []string{"a", "b", "b", "c"},
+			expected: []string{"b"},
+		},
+		{
+			name:     "several modes in first occurrence order",
+			input:    []string{"c", "a", "a", "c", "b"},
+			expected: []string{"c", "a"},
+		},
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Mode(testCase.input); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+
+	input := []int{40, 10, 30, 20}
+
+	testCases := []struct {
+		name     string
+		p        float64
+		method   PercentileMethod
+		expected float64
+	}{
+		{
+			name:     "linear",
+			p:        40,
+			method:   PercentileLinear,
+			expected: 22,
+		},
+		{
+			name:     "lower",
+			p:        40,
+			method:   PercentileLower,
+			expected: 20,
+		},
+		{
+			name:     "higher",
+			p:        40,
+			method:   PercentileHigher,
+			expected: 30,
+		},
+		{
+			name:     "nearest",
+			p:        40,
+			method:   PercentileNearest,
+			expected: 20,
+		},
+		{
+			name:     "midpoint",
+			p:        40,
+			method:   PercentileMidpoint,
+			expected: 25,
+		},
+		{
+			name:     "minimum",
+			p:        0,
+			method:   PercentileLinear,
+			expected: 10,
+		},
+		{
+			name:     "maximum",
+			p:        100,
+			method:   PercentileLinear,
+			expected: 40,
+		},
+		{
+			name:     "out of range",
+			p:        101,
+			method:   PercentileLinear,
+			expected: math.NaN(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Percentile(input, testCase.p, testCase.method); !almostEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if result := Percentile([]int{}, 50, PercentileLinear); !math.IsNaN(result) {
+		t.Errorf("expected NaN for an empty slice but got %v", result)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	t.Parallel()
+
+	if result := Product([]int{2, 3, 4}); result != 24 {
+		t.Errorf("expected 24 but got %d", result)
+	}
+	if result := Product([]float64{}); result != 1 {
+		t.Errorf("expected 1 for an empty slice but got %v", result)
+	}
+}
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	if result := Sum([]int{1, 2, 3, 4}); result != 10 {
+		t.Errorf("expected 10 but got %d", result)
+	}
+
+	floats := make([]float64, 0, 10001)
+	floats = append(floats, 1)
+	for i := 0; i < 10000; i++ {
+		floats = append(floats, 1e-16)
+	}
+	if result := Sum(floats); !almostEqual(result, 1+1e-12) {
+		t.Errorf("expected compensated sum %v but got %v", 1+1e-12, result)
+	}
+
+	if result := Sum([]float64{1e100, 1, -1e100}); result != 1 {
+		t.Errorf("expected 1 but got %v", result)
+	}
+
+	words := []string{"go", "fusion"}
+	if result := SumBy(words, func(s string) int { return len(s) }); result != 8 {
+		t.Errorf("SumBy: expected 8 but got %d", result)
+	}
+}
+
+func TestSumInfinity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []float64
+		expected float64
+	}{
+		{name: "infinity", input: []float64{math.Inf(1)}, expected: math.Inf(1)},
+		{name: "infinity then finite", input: []float64{math.Inf(1), 1}, expected: math.Inf(1)},
+		{name: "finite then negative infinity", input: []float64{1, 2, math.Inf(-1)}, expected: math.Inf(-1)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Sum(testCase.input); result != testCase.expected {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if result := Sum([]float64{math.Inf(1), math.Inf(-1)}); !math.IsNaN(result) {
+		t.Errorf("expected NaN but got %v", result)
+	}
+	if result := Mean([]float64{math.Inf(1), 2}); !math.IsInf(result, 1) {
+		t.Errorf("expected +Inf but got %v", result)
+	}
+}
+
+func TestSumOverflow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		sum  func()
+	}{
+		{name: "int8", sum: func() { Sum([]int8{100, 100}) }},
+		{name: "negative int8", sum: func() { Sum([]int8{-100, -100}) }},
+		{name: "uint8", sum: func() { Sum([]uint8{200, 100}) }},
+		{name: "SumBy", sum: func() { SumBy([]int{1, 2}, func(int) int64 { return math.MaxInt64 }) }},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ErrOverflow) {
+					t.Errorf("expected a panic with ErrOverflow but got %v", err)
+				}
+			}()
+			testCase.sum()
+		})
+	}
+
+	if result := Sum([]int8{100, 27, -100}); result != 27 {
+		t.Errorf("expected 27 but got %d", result)
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       []int8
+		expected    int8
+		expectedErr error
+	}{
+		{
+			name:     "fits",
+			input:    []int8{100, 27},
+			expected: 127,
+		},
+		{
+			name:        "positive overflow",
+			input:       []int8{100, 28},
+			expectedErr: ErrOverflow,
+		},
+		{
+			name:        "negative overflow",
+			input:       []int8{-100, -29},
+			expectedErr: ErrOverflow,
+		},
+		{
+			name:     "intermediate values cancel out",
+			input:    []int8{100, -50, 70},
+			expected: 120,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := SumChecked(testCase.input)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v but got %v", testCase.expectedErr, err)
+			}
+			if result != testCase.expected {
+				t.Errorf("expected %d but got %d", testCase.expected, result)
+			}
+		})
+	}
+
+	if _, err := SumChecked([]uint8{200, 100}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected unsigned overflow to be detected but got %v", err)
+	}
+}
+
+func TestVariance(t *testing.T) {
+	t.Parallel()
+
+	input := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	if result := Variance(input); !almostEqual(result, 4) {
+		t.Errorf("Variance: expected 4 but got %v", result)
+	}
+	if result := StdDev(input); !almostEqual(result, 2) {
+		t.Errorf("StdDev: expected 2 but got %v", result)
+	}
+	if result := SampleVariance(input); !almostEqual(result, 32.0/7) {
+		t.Errorf("SampleVariance: expected %v but got %v", 32.0/7, result)
+	}
+	if result := SampleStdDev(input); !almostEqual(result, math.Sqrt(32.0/7)) {
+		t.Errorf("SampleStdDev: expected %v but got %v", math.Sqrt(32.0/7), result)
+	}
+	if result := Variance([]int{}); !math.IsNaN(result) {
+		t.Errorf("Variance: expected NaN but got %v", result)
+	}
+	if result := SampleVariance([]int{1}); !math.IsNaN(result) {
+		t.Errorf("SampleVariance: expected NaN but got %v", result)
+	}
+}
@@ -0,0 +1,112 @@
+package fusion
+
+// MultiMap maps each key to a list of values, kept in insertion order. A key is present as long as
+// it has at least one value. The zero value is an empty multimap ready to use.
+type MultiMap[K comparable, V comparable] struct {
+	entries map[K][]V
+	count   int
+}
+
+// NewMultiMap returns an empty multimap.
+func NewMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{entries: make(map[K][]V)}
+}
+
+// MultiMapFromMap returns a multimap holding a copy of the groups of m, such as the result of GroupBy.
+// Keys with no values are skipped.
+func MultiMapFromMap[K comparable, V comparable](m map[K][]V) *MultiMap[K, V] {
+	mm := NewMultiMap[K, V]()
+	for key, values := range m {
+		mm.Put(key, values...)
+	}
+	return mm
+}
+
+// Contains reports whether value is one of the values of key.
+func (mm *MultiMap[K, V]) Contains(key K, value V) bool {
+	return Includes(mm.entries[key], value)
+}
+
+// GetAll returns a copy of the values of key in insertion order, or nil if key is not present.
+func (mm *MultiMap[K, V]) GetAll(key K) []V {
+	values, ok := mm.entries[key]
+	if !ok {
+		return nil
+	}
+	return append([]V(nil), values...)
+}
+
+// Has reports whether key has at least one value.
+func (mm *MultiMap[K, V]) Has(key K) bool {
+	_, ok := mm.entries[key]
+	return ok
+}
+
+// KeyCount returns the number of keys.
+func (mm *MultiMap[K, V]) KeyCount() int {
+	return len(mm.entries)
+}
+
+// Keys returns the keys in no particular order.
+func (mm *MultiMap[K, V]) Keys() []K {
+	return Keys(mm.entries)
+}
+
+// Put appends values to the values of key. The same value may be stored more than once.
+func (mm *MultiMap[K, V]) Put(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	if mm.entries == nil {
+		mm.entries = make(map[K][]V)
+	}
+	mm.entries[key] = append(mm.entries[key], values...)
+	mm.count += len(values)
+}
+
+// RemoveKey removes key and returns its values, or nil if key is not present.
+func (mm *MultiMap[K, V]) RemoveKey(key K) []V {
+	values, ok := mm.entries[key]
+	if !ok {
+		return nil
+	}
+	delete(mm.entries, key)
+	mm.count -= len(values)
+	return values
+}
+
+// RemoveValue removes the first occurrence of value from the values of key and reports whether it was found.
+// The key is removed once it has no values left.
+func (mm *MultiMap[K, V]) RemoveValue(key K, value V) bool {
+	values := mm.entries[key]
+	index := IndexOf(values, value)
+	if index < 0 {
+		return false
+	}
+
+	if len(values) == 1 {
+		delete(mm.entries, key)
+	} else {
+		last := len(values) - 1
+		copy(values[index:], values[index+1:])
+		var zero V
+		values[last] = zero
+		mm.entries[key] = values[:last]
+	}
+	mm.count--
+	return true
+}
+
+// ToMap returns a copy of the multimap as a map of slices.
+func (mm *MultiMap[K, V]) ToMap() map[K][]V {
+	result := make(map[K][]V, len(mm.entries))
+	for key, values := range mm.entries {
+		result[key] = append([]V(nil), values...)
+	}
+	return result
+}
+
+// ValueCount returns the total number of values across all keys.
+func (mm *MultiMap[K, V]) ValueCount() int {
+	return mm.count
+}
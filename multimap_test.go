@@ -0,0 +1,102 @@
+package fusion
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestMultiMap(t *testing.T) {
+	t.Parallel()
+
+	var mm MultiMap[string, int]
+	mm.Put("a", 1, 2)
+	mm.Put("b", 3)
+	mm.Put("a", 1)
+	mm.Put("c")
+
+	if mm.KeyCount() != 2 || mm.ValueCount() != 4 {
+		t.Errorf("expected 2 keys and 4 values but got %d and %d", mm.KeyCount(), mm.ValueCount())
+	}
+	if values := mm.GetAll("a"); !IsEqual(values, []int{1, 2, 1}) {
+		t.Errorf("expected [1 2 1] but got %v", values)
+	}
+	if values := mm.GetAll("missing"); values != nil {
+		t.Errorf("expected nil but got %v", values)
+	}
+	if !mm.Contains("a", 2) || mm.Contains("b", 2) {
+		t.Errorf("expected Contains to report only stored values")
+	}
+
+	testCases := []struct {
+		name     string
+		key      string
+		value    int
+		removed  bool
+		expected map[string][]int
+	}{
+		{
+			name:     "first occurrence",
+			key:      "a",
+			value:    1,
+			removed:  true,
+			expected: map[string][]int{"a": {2, 1}, "b": {3}},
+		},
+		{
+			name:     "missing value",
+			key:      "b",
+			value:    9,
+			removed:  false,
+			expected: map[string][]int{"a": {2, 1}, "b": {3}},
+		},
+		{
+			name:     "last value removes key",
+			key:      "b",
+			value:    3,
+			removed:  true,
+			expected: map[string][]int{"a": {2, 1}},
+		},
+	}
+
+	// The cases run in order, each removing from the state left by the previous one.
+	for _, testCase := range testCases {
+		if removed := mm.RemoveValue(testCase.key, testCase.value); removed != testCase.removed {
+			t.Errorf("%s: expected %v but got %v", testCase.name, testCase.removed, removed)
+		}
+		if result := mm.ToMap(); !IsEqual(result, testCase.expected) {
+			t.Errorf("%s: expected %v but got %v", testCase.name, testCase.expected, result)
+		}
+	}
+
+	if mm.Has("b") || mm.ValueCount() != 2 {
+		t.Errorf("expected b to be removed and 2 values left but got %d", mm.ValueCount())
+	}
+	if values := mm.RemoveKey("a"); !IsEqual(values, []int{2, 1}) || mm.KeyCount() != 0 || mm.ValueCount() != 0 {
+		t.Errorf("expected RemoveKey to empty the multimap but got %v", values)
+	}
+}
+
+func TestMultiMapFromMap(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	mm := MultiMapFromMap(GroupBy(words, func(word string) byte { return word[0] }))
+
+	keys := mm.Keys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	if expected := []byte{'a', 'b', 'c'}; !IsEqual(keys, expected) {
+		t.Errorf("expected %v but got %v", expected, keys)
+	}
+	if values := mm.GetAll('b'); !IsEqual(values, []string{"banana", "blueberry"}) {
+		t.Errorf("expected [banana blueberry] but got %v", values)
+	}
+	if mm.ValueCount() != len(words) {
+		t.Errorf("expected %d values but got %d", len(words), mm.ValueCount())
+	}
+
+	// The multimap holds its own copy of the groups.
+	values := mm.GetAll('a')
+	values[0] = "changed"
+	if first := mm.GetAll('a')[0]; first != "apple" {
+		t.Errorf("expected apple but got %s", first)
+	}
+}
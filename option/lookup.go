@@ -0,0 +1,78 @@
+package option
+
+import fusion "github.com/vatsalpatel/gofusion"
+
+// Find returns the first element of the slice that satisfies the predicate.
+func Find[T any](arr []T, predicate func(T) bool) Option[T] {
+	for _, value := range arr {
+		if predicate(value) {
+			return Some(value)
+		}
+	}
+	return None[T]()
+}
+
+// FindLast returns the last element of the slice that satisfies the predicate.
+func FindLast[T any](arr []T, predicate func(T) bool) Option[T] {
+	for i := len(arr) - 1; i >= 0; i-- {
+		if predicate(arr[i]) {
+			return Some(arr[i])
+		}
+	}
+	return None[T]()
+}
+
+// First returns the first element of the slice.
+func First[T any](arr []T) Option[T] {
+	return Nth(arr, 0)
+}
+
+// Get returns the value stored in the map under the given key.
+func Get[K comparable, V any](m map[K]V, key K) Option[V] {
+	value, ok := m[key]
+	return FromPair(value, ok)
+}
+
+// Last returns the last element of the slice.
+func Last[T any](arr []T) Option[T] {
+	return Nth(arr, -1)
+}
+
+// Max returns the largest element of the slice.
+func Max[T fusion.Ordered](arr []T) Option[T] {
+	if len(arr) == 0 {
+		return None[T]()
+	}
+	max := arr[0]
+	for _, value := range arr[1:] {
+		if value > max {
+			max = value
+		}
+	}
+	return Some(max)
+}
+
+// Min returns the smallest element of the slice.
+func Min[T fusion.Ordered](arr []T) Option[T] {
+	if len(arr) == 0 {
+		return None[T]()
+	}
+	min := arr[0]
+	for _, value := range arr[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return Some(min)
+}
+
+// Nth returns the element at index n of the slice. A negative n counts back from the end.
+func Nth[T any](arr []T, n int) Option[T] {
+	if n < 0 {
+		n += len(arr)
+	}
+	if n < 0 || n >= len(arr) {
+		return None[T]()
+	}
+	return Some(arr[n])
+}
@@ -0,0 +1,125 @@
+package option
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	testCases := []struct {
+		name         string
+		input        []int
+		expected     Option[int]
+		expectedLast Option[int]
+	}{
+		{
+			name:         "matches found",
+			input:        []int{1, 2, 3, 4, 5},
+			expected:     Some(2),
+			expectedLast: Some(4),
+		},
+		{
+			name:         "no match",
+			input:        []int{1, 3, 5},
+			expected:     None[int](),
+			expectedLast: None[int](),
+		},
+		{
+			name:         "empty slice",
+			input:        nil,
+			expected:     None[int](),
+			expectedLast: None[int](),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Find(testCase.input, isEven); result != testCase.expected {
+				t.Errorf("Find: expected %v but got %v", testCase.expected, result)
+			}
+			if result := FindLast(testCase.input, isEven); result != testCase.expectedLast {
+				t.Errorf("FindLast: expected %v but got %v", testCase.expectedLast, result)
+			}
+		})
+	}
+}
+
+func TestNth(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"a", "b", "c"}
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected Option[string]
+	}{
+		{
+			name:     "first",
+			n:        0,
+			expected: Some("a"),
+		},
+		{
+			name:     "negative index",
+			n:        -1,
+			expected: Some("c"),
+		},
+		{
+			name:     "out of range",
+			n:        3,
+			expected: None[string](),
+		},
+		{
+			name:     "negative out of range",
+			n:        -4,
+			expected: None[string](),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Nth(input, testCase.n); result != testCase.expected {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if First(input) != Some("a") || Last(input) != Some("c") {
+		t.Errorf("unexpected First or Last result")
+	}
+	if First([]string{}).IsSome() || Last([]string{}).IsSome() {
+		t.Errorf("expected First and Last of an empty slice to be None")
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"a": 1, "zero": 0}
+
+	if result := Get(m, "a"); result != Some(1) {
+		t.Errorf("expected Some(1) but got %v", result)
+	}
+	if result := Get(m, "zero"); result != Some(0) {
+		t.Errorf("expected Some(0) but got %v", result)
+	}
+	if result := Get(m, "missing"); result.IsSome() {
+		t.Errorf("expected None but got %v", result)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	t.Parallel()
+
+	input := []int{3, -1, 7, 2}
+	if result := Max(input); result != Some(7) {
+		t.Errorf("Max: expected Some(7) but got %v", result)
+	}
+	if result := Min(input); result != Some(-1) {
+		t.Errorf("Min: expected Some(-1) but got %v", result)
+	}
+	if Max([]float64{}).IsSome() || Min([]string{}).IsSome() {
+		t.Errorf("expected Max and Min of an empty slice to be None")
+	}
+}
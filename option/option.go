@@ -0,0 +1,262 @@
+// Package option provides Option and Result types for GoFusion lookups that would otherwise
+// need sentinel values such as -1 or a caller-supplied default.
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Option represents a value that may or may not be present.
+// The zero value is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding the given value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPair converts a Go "comma ok" pair into an Option.
+func FromPair[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// Map applies the function to the value of the Option if it is present.
+func Map[T any, U any](o Option[T], fn func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+// FlatMap applies a function returning an Option to the value of the Option if it is present.
+func FlatMap[T any, U any](o Option[T], fn func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return fn(o.value)
+}
+
+// IsSome reports whether the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// MustGet returns the value, and panics if the Option is empty.
+func (o Option[T]) MustGet() T {
+	if !o.ok {
+		panic("option: MustGet called on None")
+	}
+	return o.value
+}
+
+// OrElse returns the value if present, otherwise returns the provided default value.
+func (o Option[T]) OrElse(defaultValue T) T {
+	if !o.ok {
+		return defaultValue
+	}
+	return o.value
+}
+
+// OrElseGet returns the value if present, otherwise returns the result of calling fn.
+func (o Option[T]) OrElseGet(fn func() T) T {
+	if !o.ok {
+		return fn()
+	}
+	return o.value
+}
+
+// String formats the Option as Some(value) or None.
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MarshalJSON encodes None as null and Some as the encoding of its value.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// Value implements driver.Valuer, so that None is stored as SQL NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	if valuer, ok := interface{}(o.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// Scan implements sql.Scanner, so that SQL NULL is read as None.
+func (o *Option[T]) Scan(src interface{}) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if scanner, ok := interface{}(&value).(sql.Scanner); ok {
+		if err := scanner.Scan(src); err != nil {
+			return err
+		}
+	} else if err := convertAssign(reflect.ValueOf(&value).Elem(), src); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// convertAssign stores a value returned by a database driver into dst,
+// converting between numeric, string and byte representations as database/sql does.
+func convertAssign(dst reflect.Value, src interface{}) error {
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(dst.Type()) {
+		switch b := src.(type) {
+		case []byte:
+			dst.Set(reflect.ValueOf(bytes.Clone(b)))
+		default:
+			dst.Set(sv)
+		}
+		return nil
+	}
+
+	var text string
+	isText := false
+	switch s := src.(type) {
+	case string:
+		text, isText = s, true
+	case []byte:
+		text, isText = string(s), true
+	}
+
+	fail := func(err error) error {
+		if err != nil {
+			return fmt.Errorf("option: cannot scan %T into %s: %w", src, dst.Type(), err)
+		}
+		return fmt.Errorf("option: cannot scan %T into %s", src, dst.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		if isText {
+			dst.SetString(text)
+		} else {
+			dst.SetString(fmt.Sprint(src))
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isText {
+			n, err := strconv.ParseInt(text, 10, dst.Type().Bits())
+			if err != nil {
+				return fail(err)
+			}
+			dst.SetInt(n)
+			return nil
+		}
+		if sv.CanInt() && !dst.OverflowInt(sv.Int()) {
+			dst.SetInt(sv.Int())
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if isText {
+			n, err := strconv.ParseUint(text, 10, dst.Type().Bits())
+			if err != nil {
+				return fail(err)
+			}
+			dst.SetUint(n)
+			return nil
+		}
+		if sv.CanInt() && sv.Int() >= 0 && !dst.OverflowUint(uint64(sv.Int())) {
+			dst.SetUint(uint64(sv.Int()))
+			return nil
+		}
+		if sv.CanUint() && !dst.OverflowUint(sv.Uint()) {
+			dst.SetUint(sv.Uint())
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if isText {
+			f, err := strconv.ParseFloat(text, dst.Type().Bits())
+			if err != nil {
+				return fail(err)
+			}
+			dst.SetFloat(f)
+			return nil
+		}
+		if sv.CanFloat() {
+			dst.SetFloat(sv.Float())
+			return nil
+		}
+		if sv.CanInt() {
+			dst.SetFloat(float64(sv.Int()))
+			return nil
+		}
+	case reflect.Bool:
+		if isText {
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return fail(err)
+			}
+			dst.SetBool(b)
+			return nil
+		}
+		if sv.CanInt() && (sv.Int() == 0 || sv.Int() == 1) {
+			dst.SetBool(sv.Int() == 1)
+			return nil
+		}
+	}
+
+	if sv.Type().ConvertibleTo(dst.Type()) && sv.Kind() == dst.Kind() {
+		dst.Set(sv.Convert(dst.Type()))
+		return nil
+	}
+	return fail(nil)
+}
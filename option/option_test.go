@@ -0,0 +1,199 @@
+package option
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	t.Parallel()
+
+	some := Some(42)
+	if value, ok := some.Get(); !ok || value != 42 {
+		t.Errorf("expected Some(42) but got %v", some)
+	}
+	if !some.IsSome() || some.IsNone() {
+		t.Errorf("expected Some to report a value")
+	}
+
+	none := None[int]()
+	if _, ok := none.Get(); ok {
+		t.Errorf("expected None but got %v", none)
+	}
+	if none.OrElse(7) != 7 || some.OrElse(7) != 42 {
+		t.Errorf("unexpected OrElse result")
+	}
+	if none.OrElseGet(func() int { return 9 }) != 9 {
+		t.Errorf("unexpected OrElseGet result")
+	}
+
+	var zero Option[string]
+	if zero.IsSome() {
+		t.Errorf("expected the zero value to be None")
+	}
+
+	if FromPair(1, false).IsSome() || !FromPair(1, true).IsSome() {
+		t.Errorf("unexpected FromPair result")
+	}
+
+	if s := some.String(); s != "Some(42)" {
+		t.Errorf("expected Some(42) but got %s", s)
+	}
+	if s := none.String(); s != "None" {
+		t.Errorf("expected None but got %s", s)
+	}
+}
+
+func TestOptionMustGet(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustGet on None to panic")
+		}
+	}()
+	None[int]().MustGet()
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	if result := Map(Some(12), strconv.Itoa); result.OrElse("") != "12" {
+		t.Errorf("expected Some(12) but got %v", result)
+	}
+	if result := Map(None[int](), strconv.Itoa); result.IsSome() {
+		t.Errorf("expected None but got %v", result)
+	}
+
+	half := func(value int) Option[int] {
+		if value%2 != 0 {
+			return None[int]()
+		}
+		return Some(value / 2)
+	}
+	if result := FlatMap(Some(8), half); result.OrElse(0) != 4 {
+		t.Errorf("expected Some(4) but got %v", result)
+	}
+	if result := FlatMap(Some(3), half); result.IsSome() {
+		t.Errorf("expected None but got %v", result)
+	}
+}
+
+func TestOptionJSON(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		Name Option[string] `json:"name"`
+		Age  Option[int]    `json:"age"`
+	}
+
+	data, err := json.Marshal(payload{Name: Some("ann"), Age: None[int]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"name":"ann","age":null}`; string(data) != expected {
+		t.Errorf("expected %s but got %s", expected, data)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal([]byte(`{"name":null,"age":31}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Name.IsSome() {
+		t.Errorf("expected name to be None but got %v", decoded.Name)
+	}
+	if decoded.Age.OrElse(0) != 31 {
+		t.Errorf("expected age to be Some(31) but got %v", decoded.Age)
+	}
+
+	if err := json.Unmarshal([]byte(`{"age":"x"}`), &decoded); err == nil {
+		t.Errorf("expected an error for a mistyped value")
+	}
+}
+
+func TestOptionSQL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		scan     func() (interface{}, error)
+		expected interface{}
+	}{
+		{
+			name: "null",
+			scan: func() (interface{}, error) {
+				var o Option[int64]
+				err := o.Scan(nil)
+				return o, err
+			},
+			expected: None[int64](),
+		},
+		{
+			name: "int64 into int",
+			scan: func() (interface{}, error) {
+				var o Option[int]
+				err := o.Scan(int64(5))
+				return o, err
+			},
+			expected: Some(5),
+		},
+		{
+			name: "bytes into string",
+			scan: func() (interface{}, error) {
+				var o Option[string]
+				err := o.Scan([]byte("hello"))
+				return o, err
+			},
+			expected: Some("hello"),
+		},
+		{
+			name: "bytes into float",
+			scan: func() (interface{}, error) {
+				var o Option[float64]
+				err := o.Scan([]byte("2.5"))
+				return o, err
+			},
+			expected: Some(2.5),
+		},
+		{
+			name: "int64 into bool",
+			scan: func() (interface{}, error) {
+				var o Option[bool]
+				err := o.Scan(int64(1))
+				return o, err
+			},
+			expected: Some(true),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.scan()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	var overflow Option[int8]
+	if err := overflow.Scan(int64(1000)); err == nil {
+		t.Errorf("expected an overflow error but got %v", overflow)
+	}
+
+	value, err := Some(3).Value()
+	if err != nil || value != int64(3) {
+		t.Errorf("expected int64(3) but got %v (%v)", value, err)
+	}
+	value, err = None[string]().Value()
+	if err != nil || value != nil {
+		t.Errorf("expected nil but got %v (%v)", value, err)
+	}
+
+	var _ driver.Valuer = Option[int]{}
+}
@@ -0,0 +1,95 @@
+package option
+
+import "fmt"
+
+// Result holds either a value or an error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding the given value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result holding the given error.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Try converts a Go (value, error) pair into a Result.
+func Try[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// MapResult applies the function to the value of the Result if it is successful.
+func MapResult[T any, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThen applies a fallible function to the value of the Result if it is successful.
+func AndThen[T any, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Try(fn(r.value))
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error of the Result.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error of the Result, or nil if it is successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// MustGet returns the value, and panics with the error if the Result failed.
+func (r Result[T]) MustGet() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value if the Result is successful, otherwise returns the provided default value.
+func (r Result[T]) OrElse(defaultValue T) T {
+	if r.err != nil {
+		return defaultValue
+	}
+	return r.value
+}
+
+// Option converts the Result into an Option, discarding the error.
+func (r Result[T]) Option() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// String formats the Result as Ok(value) or Err(error).
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
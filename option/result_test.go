@@ -0,0 +1,103 @@
+package option
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	t.Parallel()
+
+	failure := errors.New("boom")
+
+	ok := Ok(3)
+	if value, err := ok.Get(); err != nil || value != 3 {
+		t.Errorf("expected Ok(3) but got %v", ok)
+	}
+	if !ok.IsOk() || ok.IsErr() || ok.Err() != nil {
+		t.Errorf("expected Ok to report success")
+	}
+
+	failed := Err[int](failure)
+	if !failed.IsErr() || !errors.Is(failed.Err(), failure) {
+		t.Errorf("expected Err(boom) but got %v", failed)
+	}
+	if failed.OrElse(-1) != -1 || ok.OrElse(-1) != 3 {
+		t.Errorf("unexpected OrElse result")
+	}
+	if failed.Option().IsSome() || ok.Option().OrElse(0) != 3 {
+		t.Errorf("unexpected Option conversion")
+	}
+	if s := failed.String(); s != "Err(boom)" {
+		t.Errorf("expected Err(boom) but got %s", s)
+	}
+}
+
+func TestTry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		input     string
+		expected  int
+		expectErr bool
+	}{
+		{
+			name:     "valid number",
+			input:    "42",
+			expected: 42,
+		},
+		{
+			name:      "invalid number",
+			input:     "x",
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Try(strconv.Atoi(testCase.input))
+			if result.IsErr() != testCase.expectErr {
+				t.Fatalf("expected error %v but got %v", testCase.expectErr, result)
+			}
+			if !testCase.expectErr && result.MustGet() != testCase.expected {
+				t.Errorf("expected %d but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapResult(t *testing.T) {
+	t.Parallel()
+
+	doubled := MapResult(Ok(4), func(value int) int { return value * 2 })
+	if doubled.OrElse(0) != 8 {
+		t.Errorf("expected Ok(8) but got %v", doubled)
+	}
+
+	failure := errors.New("boom")
+	if result := MapResult(Err[int](failure), strconv.Itoa); !errors.Is(result.Err(), failure) {
+		t.Errorf("expected the error to propagate but got %v", result)
+	}
+
+	parsed := AndThen(Ok("17"), strconv.Atoi)
+	if parsed.OrElse(0) != 17 {
+		t.Errorf("expected Ok(17) but got %v", parsed)
+	}
+	if result := AndThen(Ok("x"), strconv.Atoi); result.IsOk() {
+		t.Errorf("expected an error but got %v", result)
+	}
+}
+
+func TestResultMustGet(t *testing.T) {
+	t.Parallel()
+
+	failure := errors.New("boom")
+	defer func() {
+		if r := recover(); r != failure {
+			t.Errorf("expected MustGet to panic with the error but got %v", r)
+		}
+	}()
+	Err[int](failure).MustGet()
+}
@@ -0,0 +1,330 @@
+package fusion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrPoolClosed is returned by Submit once the pool has been closed.
+var ErrPoolClosed = errors.New("fusion: pool is closed")
+
+// PanicError is the error of a task whose function panicked.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("fusion: task panicked: %v", e.Value)
+}
+
+// PoolHooks are called as tasks move through a pool, for example to record metrics.
+// OnQueued runs on the goroutine that called Submit, and OnStart and OnComplete run on the
+// worker goroutines, so hooks must be safe for concurrent use.
+type PoolHooks struct {
+	// OnQueued is called by Submit when it accepts a task, before the task is handed to the workers,
+	// so it always comes before OnStart. The task is never started if Submit then returns an error.
+	OnQueued func(index int)
+	// OnStart is called by a worker when it starts a task.
+	OnStart func(index int)
+	// OnComplete is called by a worker when a task has finished, with its error and how long it ran.
+	OnComplete func(index int, err error, elapsed time.Duration)
+}
+
+// PoolOptions configures a Pool.
+type PoolOptions struct {
+	// Workers is the number of tasks run concurrently. It defaults to runtime.GOMAXPROCS(0).
+	Workers int
+	// QueueSize is the number of submitted tasks that may wait for a worker before Submit blocks.
+	// It defaults to Workers.
+	QueueSize int
+	// Ordered delivers results in submission order instead of completion order.
+	// Results that finish early are held back until every earlier result has been delivered.
+	Ordered bool
+	// Hooks are called as tasks move through the pool.
+	Hooks PoolHooks
+}
+
+// PoolResult is the outcome of a task submitted to a Pool.
+type PoolResult[In any, Out any] struct {
+	// Index is the position of the task in submission order, starting at 0.
+	// A Submit that returns an error may leave a gap in the indexes.
+	Index int
+	// Input is the value passed to Submit.
+	Input In
+	// Value is the value returned by the task function.
+	Value Out
+	// Err is the error returned by the task function, a *PanicError if it panicked,
+	// or the context error if the task was cancelled before it started.
+	Err error
+}
+
+// PoolStats is a snapshot of the tasks in a Pool.
+type PoolStats struct {
+	// Queued is the number of tasks waiting for a worker.
+	Queued int
+	// Running is the number of tasks being run.
+	Running int
+	// Completed is the number of finished tasks, including failed ones.
+	Completed int
+	// Failed is the number of finished tasks with an error.
+	Failed int
+}
+
+// Pool runs a function over submitted values on a fixed number of workers.
+// Results must be received from Results until it is closed, otherwise the workers block.
+type Pool[In any, Out any] struct {
+	fn   func(context.Context, In) (Out, error)
+	opts PoolOptions
+
+	mu         sync.Mutex
+	next       int
+	closed     bool
+	closing    chan struct{}
+	once       sync.Once
+	submitting sync.WaitGroup
+	skipped    map[int]bool
+	skip       chan struct{}
+
+	queue   chan poolTask[In]
+	results chan PoolResult[In, Out]
+	done    chan struct{}
+
+	queued    atomic.Int64
+	running   atomic.Int64
+	completed atomic.Int64
+	failed    atomic.Int64
+}
+
+type poolTask[In any] struct {
+	ctx   context.Context
+	index int
+	input In
+}
+
+// NewPool starts a pool whose workers call fn for each submitted value.
+// Each call receives the context that was passed to Submit along with the value.
+func NewPool[In any, Out any](fn func(context.Context, In) (Out, error), opts PoolOptions) *Pool[In, Out] {
+	opts = opts.withDefaults()
+	p := &Pool[In, Out]{
+		fn:      fn,
+		opts:    opts,
+		closing: make(chan struct{}),
+		skipped: make(map[int]bool),
+		skip:    make(chan struct{}, 1),
+		queue:   make(chan poolTask[In], opts.QueueSize),
+		results: make(chan PoolResult[In, Out]),
+		done:    make(chan struct{}),
+	}
+
+	out := p.results
+	if opts.Ordered {
+		out = make(chan PoolResult[In, Out])
+		go p.reorder(out)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(opts.Workers)
+	for i := 0; i < opts.Workers; i++ {
+		go func() {
+			defer wg.Done()
+			for task := range p.queue {
+				out <- p.run(task)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		if !opts.Ordered {
+			close(p.done)
+		}
+	}()
+	return p
+}
+
+// Close stops the pool from accepting tasks. Tasks that were already submitted still run,
+// and Results is closed once all of them have been delivered. Close may be called more than once.
+func (p *Pool[In, Out]) Close() {
+	p.once.Do(func() {
+		close(p.closing)
+	})
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	p.mu.Unlock()
+
+	// Submit calls that got past the closed check may still be sending to the queue.
+	p.submitting.Wait()
+	close(p.queue)
+}
+
+// Results returns the channel on which the results of all tasks are delivered.
+func (p *Pool[In, Out]) Results() <-chan PoolResult[In, Out] {
+	return p.results
+}
+
+// Stats returns the current number of queued, running and completed tasks.
+func (p *Pool[In, Out]) Stats() PoolStats {
+	return PoolStats{
+		Queued:    int(p.queued.Load()),
+		Running:   int(p.running.Load()),
+		Completed: int(p.completed.Load()),
+		Failed:    int(p.failed.Load()),
+	}
+}
+
+// Submit queues input to be run by a worker. It blocks while the queue is full and returns
+// ErrPoolClosed if the pool is closed, or the context error if ctx is done before the task is queued.
+func (p *Pool[In, Out]) Submit(ctx context.Context, input In) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return ErrPoolClosed
+	}
+	task := poolTask[In]{ctx: ctx, index: p.next, input: input}
+	p.next++
+	p.submitting.Add(1)
+	p.mu.Unlock()
+	defer p.submitting.Done()
+
+	p.queued.Add(1)
+	if p.opts.Hooks.OnQueued != nil {
+		p.opts.Hooks.OnQueued(task.index)
+	}
+
+	var err error
+	select {
+	case p.queue <- task:
+		return nil
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-p.closing:
+		err = ErrPoolClosed
+	}
+
+	p.queued.Add(-1)
+	p.skipIndex(task.index)
+	return err
+}
+
+// Wait blocks until the pool has been closed and every result has been delivered.
+func (p *Pool[In, Out]) Wait() {
+	<-p.done
+}
+
+// reorder delivers the results received from in on p.results in submission order,
+// passing over the indexes of tasks that Submit failed to queue.
+func (p *Pool[In, Out]) reorder(in <-chan PoolResult[In, Out]) {
+	defer close(p.done)
+	defer close(p.results)
+
+	pending := make(map[int]PoolResult[In, Out])
+	next := 0
+	deliver := func() {
+		for {
+			if ready, ok := pending[next]; ok {
+				delete(pending, next)
+				p.results <- ready
+			} else if !p.takeSkipped(next) {
+				return
+			}
+			next++
+		}
+	}
+
+	for {
+		select {
+		case result, ok := <-in:
+			if !ok {
+				// Every Submit has returned by now, so all skipped indexes are known.
+				deliver()
+				return
+			}
+			pending[result.Index] = result
+		case <-p.skip:
+		}
+		deliver()
+	}
+}
+
+// run calls the task function and converts a panic into a *PanicError.
+func (p *Pool[In, Out]) run(task poolTask[In]) (result PoolResult[In, Out]) {
+	p.queued.Add(-1)
+	p.running.Add(1)
+	if p.opts.Hooks.OnStart != nil {
+		p.opts.Hooks.OnStart(task.index)
+	}
+
+	result = PoolResult[In, Out]{Index: task.index, Input: task.input}
+	start := time.Now()
+	defer func() {
+		if value := recover(); value != nil {
+			result.Err = &PanicError{Value: value, Stack: debug.Stack()}
+		}
+
+		p.running.Add(-1)
+		p.completed.Add(1)
+		if result.Err != nil {
+			p.failed.Add(1)
+		}
+		if p.opts.Hooks.OnComplete != nil {
+			p.opts.Hooks.OnComplete(task.index, result.Err, time.Since(start))
+		}
+	}()
+
+	if err := task.ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	result.Value, result.Err = p.fn(task.ctx, task.input)
+	return result
+}
+
+// skipIndex records that no result will be delivered for index, so Ordered pools do not wait for it.
+func (p *Pool[In, Out]) skipIndex(index int) {
+	if !p.opts.Ordered {
+		return
+	}
+	p.mu.Lock()
+	p.skipped[index] = true
+	p.mu.Unlock()
+	select {
+	case p.skip <- struct{}{}:
+	default:
+	}
+}
+
+// takeSkipped reports whether index was skipped, forgetting it if so.
+func (p *Pool[In, Out]) takeSkipped(index int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.skipped[index] {
+		return false
+	}
+	delete(p.skipped, index)
+	return true
+}
+
+func (opts PoolOptions) withDefaults() PoolOptions {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = opts.Workers
+	}
+	return opts
+}
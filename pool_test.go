@@ -0,0 +1,327 @@
+package fusion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+	square := func(_ context.Context, value int) (int, error) {
+		// Later tasks finish first, so ordering has to be restored.
+		time.Sleep(time.Duration(10-value) * time.Millisecond)
+		if value%2 == 1 {
+			return 0, errOdd
+		}
+		return value * value, nil
+	}
+
+	testCases := []struct {
+		name string
+		opts PoolOptions
+	}{
+		{
+			name: "unordered",
+			opts: PoolOptions{Workers: 4},
+		},
+		{
+			name: "ordered",
+			opts: PoolOptions{Workers: 4, Ordered: true},
+		},
+		{
+			name: "single worker",
+			opts: PoolOptions{Workers: 1, QueueSize: 1, Ordered: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := NewPool(square, testCase.opts)
+			go func() {
+				defer pool.Close()
+				for i := 0; i < 10; i++ {
+					if err := pool.Submit(context.Background(), i); err != nil {
+						t.Errorf("expected no error but got %v", err)
+					}
+				}
+			}()
+
+			var results []PoolResult[int, int]
+			for result := range pool.Results() {
+				results = append(results, result)
+			}
+			pool.Wait()
+
+			if len(results) != 10 {
+				t.Fatalf("expected 10 results but got %d", len(results))
+			}
+			if !testCase.opts.Ordered {
+				sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+			}
+			for i, result := range results {
+				if result.Index != i || result.Input != i {
+					t.Errorf("expected index and input %d but got %d and %d", i, result.Index, result.Input)
+				}
+				if i%2 == 1 {
+					if !errors.Is(result.Err, errOdd) {
+						t.Errorf("expected %v but got %v", errOdd, result.Err)
+					}
+				} else if result.Err != nil || result.Value != i*i {
+					t.Errorf("expected %d but got %d (%v)", i*i, result.Value, result.Err)
+				}
+			}
+		})
+	}
+}
+
+func TestPoolPanic(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(func(_ context.Context, value string) (int, error) {
+		if value == "" {
+			panic("empty value")
+		}
+		return len(value), nil
+	}, PoolOptions{Workers: 2})
+
+	_ = pool.Submit(context.Background(), "")
+	_ = pool.Submit(context.Background(), "abc")
+	pool.Close()
+
+	for result := range pool.Results() {
+		var panicErr *PanicError
+		if result.Input == "" {
+			if !errors.As(result.Err, &panicErr) {
+				t.Fatalf("expected a *PanicError but got %v", result.Err)
+			}
+			if panicErr.Value != "empty value" || len(panicErr.Stack) == 0 {
+				t.Errorf("expected the panic value and a stack but got %v", panicErr)
+			}
+		} else if result.Err != nil || result.Value != 3 {
+			t.Errorf("expected 3 but got %d (%v)", result.Value, result.Err)
+		}
+	}
+	pool.Wait()
+
+	if stats := pool.Stats(); stats.Completed != 2 || stats.Failed != 1 {
+		t.Errorf("expected 2 completed and 1 failed but got %+v", stats)
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	pool := NewPool(func(_ context.Context, value int) (int, error) {
+		<-release
+		return value, nil
+	}, PoolOptions{Workers: 1, QueueSize: 1})
+
+	// One task runs and one waits in the queue, so a third Submit blocks until Close.
+	_ = pool.Submit(context.Background(), 1)
+	_ = pool.Submit(context.Background(), 2)
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- pool.Submit(context.Background(), 3)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	pool.Close()
+	pool.Close()
+
+	if err := <-blocked; !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("expected %v but got %v", ErrPoolClosed, err)
+	}
+	if err := pool.Submit(context.Background(), 4); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("expected %v but got %v", ErrPoolClosed, err)
+	}
+
+	close(release)
+	var values []int
+	for result := range pool.Results() {
+		values = append(values, result.Value)
+	}
+	pool.Wait()
+
+	sort.Ints(values)
+	if expected := []int{1, 2}; !IsEqual(values, expected) {
+		t.Errorf("expected %v but got %v", expected, values)
+	}
+}
+
+func TestPoolContext(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	pool := NewPool(func(ctx context.Context, value int) (int, error) {
+		<-release
+		return value, ctx.Err()
+	}, PoolOptions{Workers: 1, QueueSize: 1})
+
+	_ = pool.Submit(context.Background(), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := pool.Submit(ctx, 2); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	// The queue is full, so Submit gives up once its context is done.
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+	if err := pool.Submit(timeout, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v but got %v", context.DeadlineExceeded, err)
+	}
+
+	// The second task is cancelled while it waits and never runs.
+	cancel()
+	close(release)
+	pool.Close()
+
+	for result := range pool.Results() {
+		switch result.Input {
+		case 1:
+			if result.Err != nil {
+				t.Errorf("expected no error but got %v", result.Err)
+			}
+		case 2:
+			if !errors.Is(result.Err, context.Canceled) {
+				t.Errorf("expected %v but got %v", context.Canceled, result.Err)
+			}
+		default:
+			t.Errorf("unexpected result %+v", result)
+		}
+	}
+	pool.Wait()
+}
+
+func TestPoolConcurrentSubmit(t *testing.T) {
+	t.Parallel()
+
+	for _, ordered := range []bool{false, true} {
+		ordered := ordered
+		t.Run(fmt.Sprintf("ordered %v", ordered), func(t *testing.T) {
+			release := make(chan struct{})
+			pool := NewPool(func(_ context.Context, value int) (int, error) {
+				<-release
+				return value, nil
+			}, PoolOptions{Workers: 1, QueueSize: 1, Ordered: ordered})
+
+			// One task runs and one waits in the queue.
+			_ = pool.Submit(context.Background(), 0)
+			_ = pool.Submit(context.Background(), 1)
+
+			// This Submit blocks on the full queue and must not stop others from seeing their context.
+			blocked := make(chan error, 1)
+			go func() {
+				blocked <- pool.Submit(context.Background(), 2)
+			}()
+			time.Sleep(20 * time.Millisecond)
+
+			timeout, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			if err := pool.Submit(timeout, 3); !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("expected %v but got %v", context.DeadlineExceeded, err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("expected Submit to return once its context expired but it took %v", elapsed)
+			}
+
+			collected := make(chan []int)
+			go func() {
+				var inputs []int
+				for result := range pool.Results() {
+					inputs = append(inputs, result.Input)
+				}
+				collected <- inputs
+			}()
+
+			close(release)
+			if err := <-blocked; err != nil {
+				t.Errorf("expected no error but got %v", err)
+			}
+			_ = pool.Submit(context.Background(), 4)
+			pool.Close()
+			inputs := <-collected
+			pool.Wait()
+			if !ordered {
+				sort.Ints(inputs)
+			}
+			// The failed Submit leaves a gap that Ordered pools skip over.
+			if expected := []int{0, 1, 2, 4}; !reflect.DeepEqual(inputs, expected) {
+				t.Errorf("expected %v but got %v", expected, inputs)
+			}
+		})
+	}
+}
+
+func TestPoolHooks(t *testing.T) {
+	t.Parallel()
+
+	var queued, started, completed, startedFirst atomic.Int64
+	var queuedIndexes sync.Map
+	var mu sync.Mutex
+	var maxRunning int
+
+	var pool *Pool[int, int]
+	pool = NewPool(func(_ context.Context, value int) (int, error) {
+		mu.Lock()
+		if running := pool.Stats().Running; running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		return value, nil
+	}, PoolOptions{
+		Workers: 3,
+		Hooks: PoolHooks{
+			OnQueued: func(index int) {
+				queued.Add(1)
+				queuedIndexes.Store(index, true)
+			},
+			OnStart: func(index int) {
+				started.Add(1)
+				if _, ok := queuedIndexes.Load(index); !ok {
+					startedFirst.Add(1)
+				}
+			},
+			OnComplete: func(int, error, time.Duration) { completed.Add(1) },
+		},
+	})
+
+	go func() {
+		defer pool.Close()
+		for i := 0; i < 20; i++ {
+			_ = pool.Submit(context.Background(), i)
+		}
+	}()
+	for range pool.Results() {
+	}
+	pool.Wait()
+
+	if queued.Load() != 20 || started.Load() != 20 || completed.Load() != 20 {
+		t.Errorf("expected 20 calls of each hook but got %d, %d and %d", queued.Load(), started.Load(), completed.Load())
+	}
+	if startedFirst.Load() != 0 {
+		t.Errorf("expected OnQueued before OnStart but %d tasks started first", startedFirst.Load())
+	}
+	if maxRunning < 1 || maxRunning > 3 {
+		t.Errorf("expected at most 3 running tasks but got %d", maxRunning)
+	}
+	if stats, expected := pool.Stats(), (PoolStats{Completed: 20}); stats != expected {
+		t.Errorf("expected %+v but got %+v", expected, stats)
+	}
+}
@@ -0,0 +1,121 @@
+package fusion
+
+import "math"
+
+// RangeIterator lazily produces the values of a range without allocating a slice.
+// It is created by RangeIter and advanced with Next.
+type RangeIterator[T Number] struct {
+	start T
+	step  T
+	index int
+	count int
+}
+
+// RangeIter returns an iterator over the same values as Range(start, end, step).
+func RangeIter[T Number](start, end, step T) RangeIterator[T] {
+	return RangeIterator[T]{start: start, step: step, count: rangeLength(start, end, step, false)}
+}
+
+// Next returns the next value of the range, and false once the range is exhausted.
+func (it *RangeIterator[T]) Next() (T, bool) {
+	if it.index >= it.count {
+		var zero T
+		return zero, false
+	}
+	value := it.start + T(it.index)*it.step
+	it.index++
+	return value, true
+}
+
+// Len returns the number of values the iterator has yet to produce.
+func (it *RangeIterator[T]) Len() int {
+	return it.count - it.index
+}
+
+// Linspace returns n evenly spaced values from start to end, both inclusive.
+// It returns nil if n is not positive, and a slice holding only start if n is 1.
+func Linspace[T Float](start, end T, n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	if n == 1 {
+		return []T{start}
+	}
+
+	result := make([]T, n)
+	step := (end - start) / T(n-1)
+	for i := 0; i < n-1; i++ {
+		result[i] = start + T(i)*step
+	}
+	result[n-1] = end
+	return result
+}
+
+// Range returns the values from start up to, but not including, end, advancing by step.
+// A negative step counts down. It returns nil if step is zero or does not move start towards end.
+func Range[T Number](start, end, step T) []T {
+	return rangeValues(start, step, rangeLength(start, end, step, false))
+}
+
+// RangeInclusive is like Range, but includes end when it is reached by a whole number of steps.
+func RangeInclusive[T Number](start, end, step T) []T {
+	return rangeValues(start, step, rangeLength(start, end, step, true))
+}
+
+// Repeat returns a slice holding n copies of value.
+func Repeat[T any](value T, n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = value
+	}
+	return result
+}
+
+// Times invokes fn n times with the indexes 0 to n-1 and returns a slice of the results.
+func Times[T any](n int, fn func(int) T) []T {
+	if n <= 0 {
+		return nil
+	}
+	result := make([]T, n)
+	for i := range result {
+		result[i] = fn(i)
+	}
+	return result
+}
+
+// rangeEpsilon absorbs floating-point error when counting steps, so that for example
+// a range from 0 to 0.3 by 0.1 has exactly three steps.
+const rangeEpsilon = 1e-9
+
+// rangeLength returns the number of values in a range. The bounds are converted to float64
+// individually, so the span cannot overflow for narrow integer types.
+func rangeLength[T Number](start, end, step T, inclusive bool) int {
+	if step == 0 {
+		return 0
+	}
+
+	steps := (float64(end) - float64(start)) / float64(step)
+	if steps < 0 {
+		return 0
+	}
+	if inclusive {
+		return int(math.Floor(steps+rangeEpsilon)) + 1
+	}
+	return int(math.Ceil(steps - rangeEpsilon))
+}
+
+// rangeValues materializes count values starting at start and advancing by step.
+// Values are computed from the index rather than accumulated, so floating-point error does not drift.
+func rangeValues[T Number](start, step T, count int) []T {
+	if count <= 0 {
+		return nil
+	}
+	result := make([]T, count)
+	for i := range result {
+		result[i] = start + T(i)*step
+	}
+	return result
+}
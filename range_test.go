@@ -0,0 +1,214 @@
+package fusion
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		start             int
+		end               int
+		step              int
+		expected          []int
+		expectedInclusive []int
+	}{
+		{
+			name:              "ascending",
+			start:             0,
+			end:               5,
+			step:              1,
+			expected:          []int{0, 1, 2, 3, 4},
+			expectedInclusive: []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:              "step does not land on end",
+			start:             0,
+			end:               10,
+			step:              3,
+			expected:          []int{0, 3, 6, 9},
+			expectedInclusive: []int{0, 3, 6, 9},
+		},
+		{
+			name:              "negative step",
+			start:             5,
+			end:               0,
+			step:              -2,
+			expected:          []int{5, 3, 1},
+			expectedInclusive: []int{5, 3, 1},
+		},
+		{
+			name:              "reversed range",
+			start:             5,
+			end:               0,
+			step:              1,
+			expected:          nil,
+			expectedInclusive: nil,
+		},
+		{
+			name:              "empty range",
+			start:             3,
+			end:               3,
+			step:              1,
+			expected:          nil,
+			expectedInclusive: []int{3},
+		},
+		{
+			name:              "zero step",
+			start:             0,
+			end:               5,
+			step:              0,
+			expected:          nil,
+			expectedInclusive: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Range(testCase.start, testCase.end, testCase.step); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("Range: expected %v but got %v", testCase.expected, result)
+			}
+			if result := RangeInclusive(testCase.start, testCase.end, testCase.step); !reflect.DeepEqual(result, testCase.expectedInclusive) {
+				t.Errorf("RangeInclusive: expected %v but got %v", testCase.expectedInclusive, result)
+			}
+		})
+	}
+}
+
+func TestRangeFloat(t *testing.T) {
+	t.Parallel()
+
+	if result := Range(0, 0.3, 0.1); len(result) != 3 {
+		t.Errorf("expected 3 values but got %v", result)
+	}
+	if result := RangeInclusive(0, 0.3, 0.1); len(result) != 4 {
+		t.Errorf("expected 4 values but got %v", result)
+	}
+	if result, expected := Range(1.0, 0.0, -0.25), []float64{1, 0.75, 0.5, 0.25}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestRangeNarrowIntegers(t *testing.T) {
+	t.Parallel()
+
+	result := Range[int8](-100, 100, 50)
+	if expected := []int8{-100, -50, 0, 50}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+
+	if result := RangeInclusive[uint8](250, 255, 5); !reflect.DeepEqual(result, []uint8{250, 255}) {
+		t.Errorf("expected [250 255] but got %v", result)
+	}
+}
+
+func TestRangeIter(t *testing.T) {
+	t.Parallel()
+
+	it := RangeIter(10, 0, -3)
+	if it.Len() != 4 {
+		t.Errorf("expected 4 remaining values but got %d", it.Len())
+	}
+
+	var values []int
+	for value, ok := it.Next(); ok; value, ok = it.Next() {
+		values = append(values, value)
+	}
+	if expected := []int{10, 7, 4, 1}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v but got %v", expected, values)
+	}
+	if it.Len() != 0 {
+		t.Errorf("expected the iterator to be exhausted")
+	}
+
+	empty := RangeIter(0, 10, -1)
+	if _, ok := empty.Next(); ok {
+		t.Errorf("expected an empty iterator")
+	}
+}
+
+func TestRangeIterAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(10, func() {
+		it := RangeIter(0, 1000, 1)
+		for _, ok := it.Next(); ok; _, ok = it.Next() {
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations but got %v", allocs)
+	}
+}
+
+func TestLinspace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		start    float64
+		end      float64
+		n        int
+		expected []float64
+	}{
+		{
+			name:     "ascending",
+			start:    0,
+			end:      1,
+			n:        5,
+			expected: []float64{0, 0.25, 0.5, 0.75, 1},
+		},
+		{
+			name:     "descending",
+			start:    2,
+			end:      -2,
+			n:        3,
+			expected: []float64{2, 0, -2},
+		},
+		{
+			name:     "single value",
+			start:    4,
+			end:      8,
+			n:        1,
+			expected: []float64{4},
+		},
+		{
+			name:     "no values",
+			start:    0,
+			end:      1,
+			n:        0,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Linspace(testCase.start, testCase.end, testCase.n); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+
+	if result, expected := Repeat("x", 3), []string{"x", "x", "x"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+	if result := Repeat("x", 0); result != nil {
+		t.Errorf("expected nil but got %v", result)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	t.Parallel()
+
+	square := func(i int) int { return i * i }
+	if result, expected := Times(4, square), []int{0, 1, 4, 9}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+	if result := Times(-1, square); result != nil {
+		t.Errorf("expected nil but got %v", result)
+	}
+}
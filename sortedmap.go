@@ -0,0 +1,379 @@
+package fusion
+
+// SortedMap is a map that keeps its keys in ascending order, backed by an AVL tree whose nodes also
+// record the size of their subtree. Lookups, updates and rank queries are O(log n).
+// The zero value is an empty map ready to use.
+type SortedMap[K Ordered, V any] struct {
+	root *sortedNode[K, V]
+}
+
+type sortedNode[K Ordered, V any] struct {
+	key    K
+	value  V
+	left   *sortedNode[K, V]
+	right  *sortedNode[K, V]
+	height int
+	size   int
+}
+
+// NewSortedMap returns an empty sorted map.
+func NewSortedMap[K Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// SortedMapFromMap returns a sorted map holding the entries of m.
+func SortedMapFromMap[K Ordered, V any](m map[K]V) *SortedMap[K, V] {
+	sm := NewSortedMap[K, V]()
+	for key, value := range m {
+		sm.Put(key, value)
+	}
+	return sm
+}
+
+// Ceiling returns the smallest key greater than or equal to key, or false if there is none.
+func (sm *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return sm.search(key, true, true)
+}
+
+// Clone returns a copy of the map. Values are copied with assignment.
+func (sm *SortedMap[K, V]) Clone() *SortedMap[K, V] {
+	return &SortedMap[K, V]{root: sm.root.clone()}
+}
+
+// Delete removes key from the map and reports whether it was present.
+func (sm *SortedMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	sm.root = sm.root.delete(key, &deleted)
+	return deleted
+}
+
+// Each calls fn for each entry in ascending key order until fn returns false.
+func (sm *SortedMap[K, V]) Each(fn func(K, V) bool) {
+	sm.root.each(fn)
+}
+
+// Floor returns the largest key less than or equal to key, or false if there is none.
+func (sm *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+	return sm.search(key, false, true)
+}
+
+// Get returns the value stored under key, or false if key is not in the map.
+func (sm *SortedMap[K, V]) Get(key K) (V, bool) {
+	node := sm.root
+	for node != nil {
+		switch {
+		case key < node.key:
+			node = node.left
+		case node.key < key:
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Has reports whether key is in the map.
+func (sm *SortedMap[K, V]) Has(key K) bool {
+	_, ok := sm.Get(key)
+	return ok
+}
+
+// Higher returns the smallest key strictly greater than key, or false if there is none.
+func (sm *SortedMap[K, V]) Higher(key K) (K, V, bool) {
+	return sm.search(key, true, false)
+}
+
+// Keys returns the keys in ascending order.
+func (sm *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, sm.Len())
+	sm.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Len returns the number of entries in the map.
+func (sm *SortedMap[K, V]) Len() int {
+	return sm.root.len()
+}
+
+// Lower returns the largest key strictly less than key, or false if there is none.
+func (sm *SortedMap[K, V]) Lower(key K) (K, V, bool) {
+	return sm.search(key, false, false)
+}
+
+// Max returns the largest key and its value, or false if the map is empty.
+func (sm *SortedMap[K, V]) Max() (K, V, bool) {
+	return sm.Select(sm.Len() - 1)
+}
+
+// Merge returns a new sorted map holding the entries of sm and then of each of others,
+// so later maps take precedence, like Merge does for plain maps.
+func (sm *SortedMap[K, V]) Merge(others ...*SortedMap[K, V]) *SortedMap[K, V] {
+	result := sm.Clone()
+	for _, other := range others {
+		other.Each(func(key K, value V) bool {
+			result.Put(key, value)
+			return true
+		})
+	}
+	return result
+}
+
+// Min returns the smallest key and its value, or false if the map is empty.
+func (sm *SortedMap[K, V]) Min() (K, V, bool) {
+	return sm.Select(0)
+}
+
+// Omit returns a new sorted map without the given keys, like Omit does for plain maps.
+func (sm *SortedMap[K, V]) Omit(keys ...K) *SortedMap[K, V] {
+	result := sm.Clone()
+	for _, key := range keys {
+		result.Delete(key)
+	}
+	return result
+}
+
+// Pick returns a new sorted map with only the given keys, like Pick does for plain maps.
+func (sm *SortedMap[K, V]) Pick(keys ...K) *SortedMap[K, V] {
+	result := NewSortedMap[K, V]()
+	for _, key := range keys {
+		if value, ok := sm.Get(key); ok {
+			result.Put(key, value)
+		}
+	}
+	return result
+}
+
+// Put stores value under key, replacing any previous value.
+func (sm *SortedMap[K, V]) Put(key K, value V) {
+	sm.root = sm.root.put(key, value)
+}
+
+// Range calls fn in ascending order for each entry whose key is in the half-open range [from, to),
+// until fn returns false.
+func (sm *SortedMap[K, V]) Range(from, to K, fn func(K, V) bool) {
+	sm.root.rangeFrom(from, to, fn)
+}
+
+// Rank returns the number of keys less than key, which is the index key has or would have in Keys.
+func (sm *SortedMap[K, V]) Rank(key K) int {
+	rank := 0
+	node := sm.root
+	for node != nil {
+		if node.key < key {
+			rank += node.left.len() + 1
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return rank
+}
+
+// Select returns the entry at index i in ascending key order, or false if i is out of range.
+func (sm *SortedMap[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= sm.Len() {
+		var key K
+		var value V
+		return key, value, false
+	}
+
+	node := sm.root
+	for {
+		left := node.left.len()
+		switch {
+		case i < left:
+			node = node.left
+		case i > left:
+			i -= left + 1
+			node = node.right
+		default:
+			return node.key, node.value, true
+		}
+	}
+}
+
+// ToMap returns the entries as a plain map, for use with the map functions of this package.
+func (sm *SortedMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, sm.Len())
+	sm.Each(func(key K, value V) bool {
+		result[key] = value
+		return true
+	})
+	return result
+}
+
+// Values returns the values in ascending key order.
+func (sm *SortedMap[K, V]) Values() []V {
+	values := make([]V, 0, sm.Len())
+	sm.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// search finds the closest key above or below key, or key itself if inclusive is set.
+func (sm *SortedMap[K, V]) search(key K, above, inclusive bool) (K, V, bool) {
+	var found *sortedNode[K, V]
+	node := sm.root
+	for node != nil {
+		switch {
+		case inclusive && node.key == key:
+			return node.key, node.value, true
+		case above && key < node.key:
+			found = node
+			node = node.left
+		case above:
+			node = node.right
+		case node.key < key:
+			found = node
+			node = node.right
+		default:
+			node = node.left
+		}
+	}
+	if found == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return found.key, found.value, true
+}
+
+func (n *sortedNode[K, V]) balance() *sortedNode[K, V] {
+	n.update()
+	switch factor := n.left.getHeight() - n.right.getHeight(); {
+	case factor > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *sortedNode[K, V]) clone() *sortedNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	copied := *n
+	copied.left = n.left.clone()
+	copied.right = n.right.clone()
+	return &copied
+}
+
+func (n *sortedNode[K, V]) delete(key K, deleted *bool) *sortedNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case key < n.key:
+		n.left = n.left.delete(key, deleted)
+	case n.key < key:
+		n.right = n.right.delete(key, deleted)
+	default:
+		*deleted = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.key, n.value = successor.key, successor.value
+		n.right = n.right.delete(successor.key, new(bool))
+	}
+	return n.balance()
+}
+
+func (n *sortedNode[K, V]) each(fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.each(fn) && fn(n.key, n.value) && n.right.each(fn)
+}
+
+func (n *sortedNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *sortedNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *sortedNode[K, V]) put(key K, value V) *sortedNode[K, V] {
+	if n == nil {
+		return &sortedNode[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+	switch {
+	case key < n.key:
+		n.left = n.left.put(key, value)
+	case n.key < key:
+		n.right = n.right.put(key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return n.balance()
+}
+
+func (n *sortedNode[K, V]) rangeFrom(from, to K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if from < n.key && !n.left.rangeFrom(from, to, fn) {
+		return false
+	}
+	if !(n.key < from) && n.key < to && !fn(n.key, n.value) {
+		return false
+	}
+	if n.key < to {
+		return n.right.rangeFrom(from, to, fn)
+	}
+	return true
+}
+
+func (n *sortedNode[K, V]) rotateLeft() *sortedNode[K, V] {
+	right := n.right
+	n.right = right.left
+	right.left = n
+	n.update()
+	right.update()
+	return right
+}
+
+func (n *sortedNode[K, V]) rotateRight() *sortedNode[K, V] {
+	left := n.left
+	n.left = left.right
+	left.right = n
+	n.update()
+	left.update()
+	return left
+}
+
+func (n *sortedNode[K, V]) update() {
+	n.height = 1 + n.left.getHeight()
+	if right := n.right.getHeight(); right >= n.height {
+		n.height = right + 1
+	}
+	n.size = 1 + n.left.len() + n.right.len()
+}
@@ -0,0 +1,215 @@
+package fusion
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// checkSortedNode verifies the AVL and size invariants of a subtree and returns its height and size.
+func checkSortedNode[K Ordered, V any](t *testing.T, n *sortedNode[K, V]) (int, int) {
+	t.Helper()
+	if n == nil {
+		return 0, 0
+	}
+	leftHeight, leftSize := checkSortedNode(t, n.left)
+	rightHeight, rightSize := checkSortedNode(t, n.right)
+	if (n.left != nil && !(n.left.key < n.key)) || (n.right != nil && !(n.key < n.right.key)) {
+		t.Fatalf("expected keys in order around %v", n.key)
+	}
+	if diff := leftHeight - rightHeight; diff > 1 || diff < -1 {
+		t.Fatalf("expected a balanced tree at %v but heights are %d and %d", n.key, leftHeight, rightHeight)
+	}
+	height := 1 + Max([]int{leftHeight, rightHeight})
+	if n.height != height || n.size != leftSize+rightSize+1 {
+		t.Fatalf("expected height %d and size %d at %v but got %d and %d", height, leftSize+rightSize+1, n.key, n.height, n.size)
+	}
+	return height, n.size
+}
+
+func TestSortedMap(t *testing.T) {
+	t.Parallel()
+
+	sm := SortedMapFromMap(map[int]string{50: "e", 10: "a", 30: "c", 20: "b", 40: "d"})
+
+	if expected := []int{10, 20, 30, 40, 50}; !IsEqual(sm.Keys(), expected) {
+		t.Errorf("expected keys %v but got %v", expected, sm.Keys())
+	}
+	if expected := []string{"a", "b", "c", "d", "e"}; !IsEqual(sm.Values(), expected) {
+		t.Errorf("expected values %v but got %v", expected, sm.Values())
+	}
+
+	testCases := []struct {
+		name     string
+		search   func(int) (int, string, bool)
+		input    int
+		expected int
+		found    bool
+	}{
+		{name: "floor exact", search: sm.Floor, input: 30, expected: 30, found: true},
+		{name: "floor between", search: sm.Floor, input: 35, expected: 30, found: true},
+		{name: "floor below", search: sm.Floor, input: 5, found: false},
+		{name: "ceiling exact", search: sm.Ceiling, input: 30, expected: 30, found: true},
+		{name: "ceiling between", search: sm.Ceiling, input: 35, expected: 40, found: true},
+		{name: "ceiling above", search: sm.Ceiling, input: 55, found: false},
+		{name: "lower exact", search: sm.Lower, input: 30, expected: 20, found: true},
+		{name: "lower smallest", search: sm.Lower, input: 10, found: false},
+		{name: "higher exact", search: sm.Higher, input: 30, expected: 40, found: true},
+		{name: "higher largest", search: sm.Higher, input: 50, found: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			key, _, found := testCase.search(testCase.input)
+			if found != testCase.found || (found && key != testCase.expected) {
+				t.Errorf("expected %v (%v) but got %v (%v)", testCase.expected, testCase.found, key, found)
+			}
+		})
+	}
+
+	if key, value, ok := sm.Min(); !ok || key != 10 || value != "a" {
+		t.Errorf("expected min 10 but got %v", key)
+	}
+	if key, value, ok := sm.Max(); !ok || key != 50 || value != "e" {
+		t.Errorf("expected max 50 but got %v", key)
+	}
+	if rank := sm.Rank(35); rank != 3 {
+		t.Errorf("expected rank 3 but got %d", rank)
+	}
+	if key, _, ok := sm.Select(1); !ok || key != 20 {
+		t.Errorf("expected 20 at index 1 but got %v", key)
+	}
+
+	var ranged []int
+	sm.Range(20, 50, func(key int, _ string) bool {
+		ranged = append(ranged, key)
+		return true
+	})
+	if expected := []int{20, 30, 40}; !IsEqual(ranged, expected) {
+		t.Errorf("expected range %v but got %v", expected, ranged)
+	}
+
+	var limited []int
+	sm.Each(func(key int, _ string) bool {
+		limited = append(limited, key)
+		return len(limited) < 2
+	})
+	if expected := []int{10, 20}; !IsEqual(limited, expected) {
+		t.Errorf("expected %v but got %v", expected, limited)
+	}
+}
+
+func TestSortedMapAdapters(t *testing.T) {
+	t.Parallel()
+
+	sm := SortedMapFromMap(map[string]int{"b": 2, "a": 1, "c": 3})
+
+	if result := sm.Pick("a", "c", "z").ToMap(); !IsEqual(result, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("Pick: expected a and c but got %v", result)
+	}
+	if result := sm.Omit("a").Keys(); !IsEqual(result, []string{"b", "c"}) {
+		t.Errorf("Omit: expected [b c] but got %v", result)
+	}
+
+	other := SortedMapFromMap(map[string]int{"c": 30, "d": 4})
+	merged := sm.Merge(other)
+	if expected := map[string]int{"a": 1, "b": 2, "c": 30, "d": 4}; !IsEqual(merged.ToMap(), expected) {
+		t.Errorf("Merge: expected %v but got %v", expected, merged.ToMap())
+	}
+	if value, _ := sm.Get("c"); value != 3 {
+		t.Errorf("expected Merge to leave the receiver unchanged but got %d", value)
+	}
+
+	// ToMap bridges to the plain map functions.
+	keys := Keys(sm.ToMap())
+	sort.Strings(keys)
+	if expected := []string{"a", "b", "c"}; !IsEqual(keys, expected) {
+		t.Errorf("expected %v but got %v", expected, keys)
+	}
+
+	var empty SortedMap[int, int]
+	if _, _, ok := empty.Min(); ok {
+		t.Errorf("expected Min on an empty map to return false")
+	}
+	if empty.Delete(1) || empty.Len() != 0 {
+		t.Errorf("expected Delete on an empty map to return false")
+	}
+}
+
+func TestSortedMapRandom(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	sm := NewSortedMap[int, int]()
+	reference := make(map[int]int)
+
+	for step := 0; step < 3000; step++ {
+		key := rng.Intn(200)
+		if rng.Intn(3) == 0 {
+			_, expected := reference[key]
+			if deleted := sm.Delete(key); deleted != expected {
+				t.Fatalf("step %d: expected Delete(%d) to return %v", step, key, expected)
+			}
+			delete(reference, key)
+		} else {
+			sm.Put(key, step)
+			reference[key] = step
+		}
+
+		if step%100 != 0 {
+			continue
+		}
+		checkSortedNode(t, sm.root)
+
+		keys := Keys(reference)
+		sort.Ints(keys)
+		if !IsEqualWith(sm.Keys(), keys, EqualOptions{NilEqualsEmpty: true}) {
+			t.Fatalf("step %d: expected keys %v but got %v", step, keys, sm.Keys())
+		}
+
+		for probe := -1; probe <= 201; probe++ {
+			index := sort.SearchInts(keys, probe)
+			if rank := sm.Rank(probe); rank != index {
+				t.Fatalf("step %d: expected Rank(%d) %d but got %d", step, probe, index, rank)
+			}
+
+			expectKey := func(name string, key int, ok bool, expectedIndex int) {
+				expectedOK := expectedIndex >= 0 && expectedIndex < len(keys)
+				if ok != expectedOK || (ok && key != keys[expectedIndex]) {
+					t.Fatalf("step %d: unexpected %s(%d): %d %v", step, name, probe, key, ok)
+				}
+			}
+			_, present := reference[probe]
+			floor, ceiling, lower, higher := index-1, index, index-1, index
+			if present {
+				floor, higher = index, index+1
+			}
+			key, _, ok := sm.Floor(probe)
+			expectKey("Floor", key, ok, floor)
+			key, _, ok = sm.Ceiling(probe)
+			expectKey("Ceiling", key, ok, ceiling)
+			key, _, ok = sm.Lower(probe)
+			expectKey("Lower", key, ok, lower)
+			key, _, ok = sm.Higher(probe)
+			expectKey("Higher", key, ok, higher)
+		}
+
+		for i, key := range keys {
+			selected, value, ok := sm.Select(i)
+			if !ok || selected != key || value != reference[key] {
+				t.Fatalf("step %d: expected Select(%d) to return %d but got %d", step, i, key, selected)
+			}
+		}
+
+		from, to := rng.Intn(200), rng.Intn(200)
+		var ranged []int
+		sm.Range(from, to, func(key int, _ int) bool {
+			ranged = append(ranged, key)
+			return true
+		})
+		expected := Filter(keys, func(_ int, key int, _ interface{}) bool { return key >= from && key < to }, nil)
+		if !IsEqualWith(ranged, expected, EqualOptions{NilEqualsEmpty: true}) {
+			t.Fatalf("step %d: expected Range(%d, %d) %v but got %v", step, from, to, expected, ranged)
+		}
+	}
+}
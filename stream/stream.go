@@ -0,0 +1,274 @@
+// Package stream provides GoFusion operators over channels, for pipelines whose data is never fully materialized.
+//
+// Every operator starts its goroutines on call and returns receive-only channels that are closed
+// once the input is exhausted or the context is cancelled. No goroutine outlives both: after the
+// context is cancelled, operators stop sending and return even if nobody reads their output.
+// Operators never close or drain their inputs; a producer blocked on an input after cancellation
+// must watch the same context.
+package stream
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ChunkChan groups the values of in into slices of size values. The last chunk holds the remaining
+// values and may be shorter. If size is not positive, the returned channel is closed immediately.
+func ChunkChan[T any](ctx context.Context, in <-chan T, size int) <-chan []T {
+	return ChunkChanByTime(ctx, in, size, 0)
+}
+
+// ChunkChanByTime is like ChunkChan, but also emits a shorter chunk once interval has elapsed since
+// the first value of the chunk was received. A non-positive interval disables the time limit.
+func ChunkChanByTime[T any](ctx context.Context, in <-chan T, size int, interval time.Duration) <-chan []T {
+	out := make(chan []T)
+	if size <= 0 {
+		close(out)
+		return out
+	}
+
+	go func() {
+		defer close(out)
+
+		var chunk []T
+		var timer *time.Timer
+		var deadline <-chan time.Time
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, deadline = nil, nil
+			}
+		}
+		defer stopTimer()
+
+		flush := func() bool {
+			stopTimer()
+			if len(chunk) == 0 {
+				return true
+			}
+			ok := send(ctx, out, chunk)
+			chunk = nil
+			return ok
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-deadline:
+				timer, deadline = nil, nil
+				if !flush() {
+					return
+				}
+			case value, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				chunk = append(chunk, value)
+				if len(chunk) == 1 && interval > 0 {
+					timer = time.NewTimer(interval)
+					deadline = timer.C
+				}
+				if len(chunk) == size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// FanOut distributes the values of in over n output channels. Each value is delivered to exactly one
+// output, whichever is ready first, so slow consumers receive fewer values.
+// If n is not positive, it returns nil.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		return nil
+	}
+
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			forEach(ctx, in, func(value T) bool {
+				return send(ctx, out, value)
+			})
+		}()
+	}
+	return outs
+}
+
+// FilterChan forwards the values of in for which the predicate returns true.
+func FilterChan[T any](ctx context.Context, in <-chan T, predicate func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		forEach(ctx, in, func(value T) bool {
+			return !predicate(value) || send(ctx, out, value)
+		})
+	}()
+	return out
+}
+
+// MapChan forwards the result of applying fn to each value of in.
+func MapChan[T any, U any](ctx context.Context, in <-chan T, fn func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		forEach(ctx, in, func(value T) bool {
+			return send(ctx, out, fn(value))
+		})
+	}()
+	return out
+}
+
+// MergeChans forwards the values of all inputs to a single channel, which is closed once every input is closed.
+// The order of values from different inputs is not defined.
+func MergeChans[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		in := in
+		go func() {
+			defer wg.Done()
+			forEach(ctx, in, func(value T) bool {
+				return send(ctx, out, value)
+			})
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// TakeChan forwards the first n values of in and then closes its output.
+// The remaining values of in are left unread.
+func TakeChan[T any](ctx context.Context, in <-chan T, n int) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		forEach(ctx, in, func(value T) bool {
+			if !send(ctx, out, value) {
+				return false
+			}
+			taken++
+			return taken < n
+		})
+	}()
+	return out
+}
+
+// Tee copies every value of in to n output channels. A value is only read from in once every
+// output has received the previous one, so the slowest consumer sets the pace.
+// If n is not positive, it returns nil.
+func Tee[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		return nil
+	}
+
+	outs := make([]chan T, n)
+	result := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		forEach(ctx, in, func(value T) bool {
+			pending := make([]chan T, n)
+			copy(pending, outs)
+			for remaining := n; remaining > 0; remaining-- {
+				if !sendAny(ctx, pending, value) {
+					return false
+				}
+			}
+			return true
+		})
+	}()
+	return result
+}
+
+// UniqChan forwards the values of in that have not been seen before.
+// It remembers every distinct value, so memory grows with the number of distinct values.
+func UniqChan[T comparable](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		seen := make(map[T]struct{})
+		forEach(ctx, in, func(value T) bool {
+			if _, ok := seen[value]; ok {
+				return true
+			}
+			seen[value] = struct{}{}
+			return send(ctx, out, value)
+		})
+	}()
+	return out
+}
+
+// forEach calls fn for each value of in until in is closed, the context is cancelled or fn returns false.
+func forEach[T any](ctx context.Context, in <-chan T, fn func(T) bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case value, ok := <-in:
+			if !ok || !fn(value) {
+				return
+			}
+		}
+	}
+}
+
+// send delivers value to out, and reports false if the context was cancelled first.
+func send[T any](ctx context.Context, out chan<- T, value T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- value:
+		return true
+	}
+}
+
+// sendAny delivers value to whichever pending channel is ready first and removes that channel from pending
+// by setting it to nil. It reports false if the context was cancelled first.
+func sendAny[T any](ctx context.Context, pending []chan T, value T) bool {
+	cases := make([]reflect.SelectCase, 0, len(pending)+1)
+	indices := make([]int, 0, len(pending))
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	sent := reflect.ValueOf(&value).Elem()
+	for i, ch := range pending {
+		if ch == nil {
+			continue
+		}
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(ch), Send: sent})
+		indices = append(indices, i)
+	}
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == 0 {
+		return false
+	}
+	pending[indices[chosen-1]] = nil
+	return true
+}
@@ -0,0 +1,422 @@
+package stream
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The tests in this package do not call t.Parallel, because verifyNoLeaks inspects every goroutine
+// in the process and would otherwise see operators started by other tests.
+
+// verifyNoLeaks fails the test if goroutines started by the operators of this package are still running.
+// Operators may need a moment to observe cancellation, so it polls before giving up.
+func verifyNoLeaks(t *testing.T) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		leaked := streamGoroutines()
+		if len(leaked) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Errorf("expected no leaked goroutines but got %d:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// streamGoroutines returns the stacks of goroutines running code of this package,
+// ignoring test functions and test helpers, whose names start with "Test" or "test".
+func streamGoroutines() []string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	const pkg = "github.com/vatsalpatel/gofusion/stream."
+	var leaked []string
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		if !strings.Contains(stack, pkg) || strings.Contains(stack, pkg+"Test") || strings.Contains(stack, pkg+"test") {
+			continue
+		}
+		leaked = append(leaked, stack)
+	}
+	return leaked
+}
+
+// testProduce returns a channel that yields values and is then closed, stopping early if ctx is cancelled.
+func testProduce[T any](ctx context.Context, values ...T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, value := range values {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- value:
+			}
+		}
+	}()
+	return out
+}
+
+// testCollect reads in until it is closed.
+func testCollect[T any](in <-chan T) []T {
+	var result []T
+	for value := range in {
+		result = append(result, value)
+	}
+	return result
+}
+
+// testInfinite returns a channel that yields increasing integers until ctx is cancelled.
+func testInfinite(ctx context.Context) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- i:
+			}
+		}
+	}()
+	return out
+}
+
+// testWaitClosed returns a channel that is closed once in is closed. It discards any value still in flight.
+func testWaitClosed[T any](in <-chan T) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		for range in {
+		}
+		close(done)
+	}()
+	return done
+}
+
+func TestChunkChan(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	testCases := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "exact chunks",
+			input:    []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "short last chunk",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "empty input",
+			input:    []int{},
+			size:     3,
+			expected: nil,
+		},
+		{
+			name:     "invalid size",
+			input:    []int{1, 2},
+			size:     0,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			result := testCollect(ChunkChan(ctx, testProduce(ctx, testCase.input...), testCase.size))
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestChunkChanByTime(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := make(chan int)
+	out := ChunkChanByTime(ctx, in, 10, 20*time.Millisecond)
+
+	in <- 1
+	in <- 2
+	if result, expected := <-out, []int{1, 2}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+
+	for i := 0; i < 10; i++ {
+		in <- i
+	}
+	if result, expected := <-out, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+
+	in <- 3
+	close(in)
+	if result, expected := testCollect(out), [][]int{{3}}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	outs := FanOut(ctx, testProduce(ctx, input...), 3)
+	if len(outs) != 3 {
+		t.Fatalf("expected 3 outputs but got %d", len(outs))
+	}
+
+	result := testCollect(MergeChans(ctx, outs...))
+	sort.Ints(result)
+	if !reflect.DeepEqual(result, input) {
+		t.Errorf("expected %v but got %v", input, result)
+	}
+
+	if outs := FanOut(ctx, testProduce[int](ctx), 0); outs != nil {
+		t.Errorf("expected nil but got %v", outs)
+	}
+}
+
+func TestFilterChan(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	isEven := func(value int) bool { return value%2 == 0 }
+	result := testCollect(FilterChan(ctx, testProduce(ctx, 1, 2, 3, 4, 5, 6), isEven))
+	if expected := []int{2, 4, 6}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestMapChan(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	double := func(value int) int { return value * 2 }
+	result := testCollect(MapChan(ctx, testProduce(ctx, 1, 2, 3), double))
+	if expected := []int{2, 4, 6}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestMergeChans(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	result := testCollect(MergeChans(ctx, testProduce(ctx, 1, 2), testProduce(ctx, 3), testProduce[int](ctx), testProduce(ctx, 4, 5)))
+	sort.Ints(result)
+	if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+
+	if result := testCollect(MergeChans[int](ctx)); result != nil {
+		t.Errorf("expected no values but got %v", result)
+	}
+}
+
+func TestTakeChan(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{
+			name:     "take some",
+			n:        3,
+			expected: []int{0, 1, 2},
+		},
+		{
+			name:     "take none",
+			n:        0,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			result := testCollect(TakeChan(ctx, testInfinite(ctx), testCase.n))
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := testCollect(TakeChan(ctx, testProduce(ctx, 1, 2), 5))
+	if expected := []int{1, 2}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestTee(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	outs := Tee(ctx, testProduce(ctx, 1, 2, 3), 2)
+	if len(outs) != 2 {
+		t.Fatalf("expected 2 outputs but got %d", len(outs))
+	}
+
+	// Reading the second output first must not block the first one.
+	results := make([][]int, 2)
+	done := make(chan struct{})
+	go func() {
+		results[1] = testCollect(outs[1])
+		close(done)
+	}()
+	results[0] = testCollect(outs[0])
+	<-done
+
+	expected := []int{1, 2, 3}
+	for i, result := range results {
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("output %d: expected %v but got %v", i, expected, result)
+		}
+	}
+
+	var nilValues []error
+	for value := range Tee(ctx, testProduce[error](ctx, nil), 1)[0] {
+		nilValues = append(nilValues, value)
+	}
+	if len(nilValues) != 1 || nilValues[0] != nil {
+		t.Errorf("expected a single nil value but got %v", nilValues)
+	}
+}
+
+func TestUniqChan(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	result := testCollect(UniqChan(ctx, testProduce(ctx, "a", "b", "a", "c", "b")))
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestCancellation(t *testing.T) {
+	defer verifyNoLeaks(t)
+
+	isEven := func(value int) bool { return value%2 == 0 }
+	identity := func(value int) int { return value }
+
+	testCases := []struct {
+		name  string
+		build func(ctx context.Context, in <-chan int) []<-chan int
+	}{
+		{
+			name: "MapChan",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{MapChan(ctx, in, identity)}
+			},
+		},
+		{
+			name: "FilterChan",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{FilterChan(ctx, in, isEven)}
+			},
+		},
+		{
+			name: "UniqChan",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{UniqChan(ctx, in)}
+			},
+		},
+		{
+			name: "TakeChan",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{TakeChan(ctx, in, 100)}
+			},
+		},
+		{
+			name: "MergeChans",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{MergeChans(ctx, in, in)}
+			},
+		},
+		{
+			name: "FanOut",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return FanOut(ctx, in, 3)
+			},
+		},
+		{
+			name: "Tee",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return Tee(ctx, in, 3)
+			},
+		},
+		{
+			name: "ChunkChanByTime",
+			build: func(ctx context.Context, in <-chan int) []<-chan int {
+				return []<-chan int{MapChan(ctx, ChunkChanByTime(ctx, in, 4, time.Hour), func(chunk []int) int { return len(chunk) })}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			outs := testCase.build(ctx, testInfinite(ctx))
+
+			// Read a single value, then abandon the outputs without draining them.
+			<-outs[0]
+			cancel()
+			verifyNoLeaks(t)
+
+			for i, out := range outs {
+				select {
+				case <-testWaitClosed(out):
+				case <-time.After(2 * time.Second):
+					t.Errorf("output %d: expected to be closed after cancellation", i)
+				}
+			}
+		})
+	}
+}
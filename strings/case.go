@@ -0,0 +1,129 @@
+// Package strings provides lodash-style string helpers for GoFusion, such as case conversion,
+// padding and truncation. Word splitting and width measurement are Unicode-aware.
+package strings
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CamelCase converts s to camel case, such as "httpServerError".
+func CamelCase(s string) string {
+	words := Words(s)
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = capitalize(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// KebabCase converts s to kebab case, such as "http-server-error".
+func KebabCase(s string) string {
+	return joinWords(s, "-", strings.ToLower)
+}
+
+// PascalCase converts s to Pascal case, such as "HttpServerError".
+func PascalCase(s string) string {
+	return joinWords(s, "", capitalize)
+}
+
+// ScreamingSnake converts s to screaming snake case, such as "HTTP_SERVER_ERROR".
+func ScreamingSnake(s string) string {
+	return joinWords(s, "_", strings.ToUpper)
+}
+
+// SnakeCase converts s to snake case, such as "http_server_error".
+func SnakeCase(s string) string {
+	return joinWords(s, "_", strings.ToLower)
+}
+
+// TitleCase converts s to space separated words with an upper case first letter, such as "HTTP Server Error".
+// Like lodash's startCase, the remaining letters of each word keep their case, so acronyms are preserved.
+func TitleCase(s string) string {
+	return joinWords(s, " ", upperFirst)
+}
+
+// Words splits s into words. Words are separated by any rune that is not a letter, digit or combining mark,
+// by a lower case letter or digit followed by an upper case letter ("fooBar" is "foo", "Bar"),
+// and at the end of an acronym ("HTTPServer" is "HTTP", "Server").
+// Digits stay attached to the surrounding word ("base64Encode" is "base64", "Encode"),
+// and apostrophes are dropped ("don't" is "dont").
+func Words(s string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r == '\'' || r == '’':
+			continue
+		case isMark(r):
+			// Combining marks belong to the letter before them, as in decomposed accents and Indic scripts.
+			current = append(current, r)
+			continue
+		case !unicode.IsLetter(r) && !unicode.IsNumber(r):
+			flush()
+			continue
+		case isUpper(r) && len(current) > 0:
+			// Case is decided by the letters themselves, so marks are skipped on either side.
+			prev := current[len(current)-1]
+			for j := len(current) - 2; isMark(prev) && j >= 0; j-- {
+				prev = current[j]
+			}
+			next := i + 1
+			for next < len(runes) && isMark(runes[next]) {
+				next++
+			}
+			if !isUpper(prev) {
+				flush()
+			} else if next < len(runes) && unicode.IsLower(runes[next]) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+// capitalize converts the first letter of word to upper case and the rest to lower case.
+func capitalize(word string) string {
+	return upperFirst(strings.ToLower(word))
+}
+
+func isMark(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me)
+}
+
+func isUpper(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+func joinWords(s, separator string, transform func(string) string) string {
+	words := Words(s)
+	for i, word := range words {
+		words[i] = transform(word)
+	}
+	return strings.Join(words, separator)
+}
+
+// upperFirst converts the first letter of word to upper case.
+func upperFirst(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if size == 0 {
+		return word
+	}
+	return string(unicode.ToUpper(r)) + word[size:]
+}
@@ -0,0 +1,183 @@
+package strings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "separators",
+			input:    "fred, barney, & pebbles",
+			expected: []string{"fred", "barney", "pebbles"},
+		},
+		{
+			name:     "camel case",
+			input:    "fooBarBaz",
+			expected: []string{"foo", "Bar", "Baz"},
+		},
+		{
+			name:     "acronym",
+			input:    "HTTPServer",
+			expected: []string{"HTTP", "Server"},
+		},
+		{
+			name:     "acronym in the middle",
+			input:    "getHTTPResponseCode",
+			expected: []string{"get", "HTTP", "Response", "Code"},
+		},
+		{
+			name:     "trailing acronym",
+			input:    "userID",
+			expected: []string{"user", "ID"},
+		},
+		{
+			name:     "digits",
+			input:    "base64Encode v2",
+			expected: []string{"base64", "Encode", "v2"},
+		},
+		{
+			name:     "screaming snake",
+			input:    "__FOO_BAR__",
+			expected: []string{"FOO", "BAR"},
+		},
+		{
+			name:     "apostrophe",
+			input:    "don't stop",
+			expected: []string{"dont", "stop"},
+		},
+		{
+			name:     "unicode letters",
+			input:    "ÉcoleNormale straße",
+			expected: []string{"École", "Normale", "straße"},
+		},
+		{
+			name:     "decomposed accents",
+			input:    "cafe\u0301 Crème\u0300Brûlée",
+			expected: []string{"cafe\u0301", "Crème\u0300", "Brûlée"},
+		},
+		{
+			name:     "decomposed all caps",
+			input:    "E\u0301TAT HE\u0301LLO",
+			expected: []string{"E\u0301TAT", "HE\u0301LLO"},
+		},
+		{
+			name:     "decomposed acronym before a word",
+			input:    "XMLE\u0301cole",
+			expected: []string{"XML", "E\u0301cole"},
+		},
+		{
+			name:     "indic script",
+			input:    "नमस्ते दुनिया",
+			expected: []string{"नमस्ते", "दुनिया"},
+		},
+		{
+			name:     "empty string",
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Words(testCase.input); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %q but got %q", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestCaseConversion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input          string
+		camel          string
+		pascal         string
+		snake          string
+		kebab          string
+		screamingSnake string
+		title          string
+	}{
+		{
+			input:          "HTTPServer",
+			camel:          "httpServer",
+			pascal:         "HttpServer",
+			snake:          "http_server",
+			kebab:          "http-server",
+			screamingSnake: "HTTP_SERVER",
+			title:          "HTTP Server",
+		},
+		{
+			input:          "foo bar baz",
+			camel:          "fooBarBaz",
+			pascal:         "FooBarBaz",
+			snake:          "foo_bar_baz",
+			kebab:          "foo-bar-baz",
+			screamingSnake: "FOO_BAR_BAZ",
+			title:          "Foo Bar Baz",
+		},
+		{
+			input:          "--user-id--",
+			camel:          "userId",
+			pascal:         "UserId",
+			snake:          "user_id",
+			kebab:          "user-id",
+			screamingSnake: "USER_ID",
+			title:          "User Id",
+		},
+		{
+			input:          "utf8Decoder2",
+			camel:          "utf8Decoder2",
+			pascal:         "Utf8Decoder2",
+			snake:          "utf8_decoder2",
+			kebab:          "utf8-decoder2",
+			screamingSnake: "UTF8_DECODER2",
+			title:          "Utf8 Decoder2",
+		},
+		{
+			input:          "émile zola",
+			camel:          "émileZola",
+			pascal:         "ÉmileZola",
+			snake:          "émile_zola",
+			kebab:          "émile-zola",
+			screamingSnake: "ÉMILE_ZOLA",
+			title:          "Émile Zola",
+		},
+		{
+			input:          "HE\u0301LLO world",
+			camel:          "he\u0301lloWorld",
+			pascal:         "He\u0301lloWorld",
+			snake:          "he\u0301llo_world",
+			kebab:          "he\u0301llo-world",
+			screamingSnake: "HE\u0301LLO_WORLD",
+			title:          "HE\u0301LLO World",
+		},
+		{
+			input: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			check := func(name, expected, result string) {
+				if result != expected {
+					t.Errorf("%s: expected %q but got %q", name, expected, result)
+				}
+			}
+			check("CamelCase", testCase.camel, CamelCase(testCase.input))
+			check("PascalCase", testCase.pascal, PascalCase(testCase.input))
+			check("SnakeCase", testCase.snake, SnakeCase(testCase.input))
+			check("KebabCase", testCase.kebab, KebabCase(testCase.input))
+			check("ScreamingSnake", testCase.screamingSnake, ScreamingSnake(testCase.input))
+			check("TitleCase", testCase.title, TitleCase(testCase.input))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package strings
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var htmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&#39;",
+)
+
+// regExpMeta lists the characters regexp.QuoteMeta escapes.
+const regExpMeta = `\.+*?()|[]{}^$`
+
+// Escape converts the characters "&", "<", ">", '"' and "'" in s to their HTML entities.
+func Escape(s string) string {
+	return htmlEscaper.Replace(s)
+}
+
+// EscapeRegExp escapes all regular expression metacharacters in s.
+func EscapeRegExp(s string) string {
+	return regexp.QuoteMeta(s)
+}
+
+// Unescape converts HTML entities in s back to the characters they represent.
+// It is the inverse of Escape and also understands named and numeric entities that Escape does not produce.
+func Unescape(s string) string {
+	return html.UnescapeString(s)
+}
+
+// UnescapeRegExp removes the backslashes EscapeRegExp adds in front of regular expression metacharacters.
+func UnescapeRegExp(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(regExpMeta, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package strings
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestEscape(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "special characters",
+			input:    `fred, barney, & <pebbles> "quoted" 'single'`,
+			expected: "fred, barney, &amp; &lt;pebbles&gt; &quot;quoted&quot; &#39;single&#39;",
+		},
+		{
+			name:     "nothing to escape",
+			input:    "plain",
+			expected: "plain",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Escape(testCase.input)
+			if result != testCase.expected {
+				t.Errorf("Escape: expected %q but got %q", testCase.expected, result)
+			}
+			if unescaped := Unescape(result); unescaped != testCase.input {
+				t.Errorf("Unescape: expected %q but got %q", testCase.input, unescaped)
+			}
+		})
+	}
+
+	if result := Unescape("&copy; &#x263A;"); result != "© ☺" {
+		t.Errorf("Unescape: expected named and numeric entities to be decoded but got %q", result)
+	}
+}
+
+func TestEscapeRegExp(t *testing.T) {
+	t.Parallel()
+
+	input := "[lodash](https://lodash.com/) costs $5.00?"
+	escaped := EscapeRegExp(input)
+
+	if !regexp.MustCompile("^" + escaped + "$").MatchString(input) {
+		t.Errorf("expected %q to match the input literally", escaped)
+	}
+	if result := UnescapeRegExp(escaped); result != input {
+		t.Errorf("UnescapeRegExp: expected %q but got %q", input, result)
+	}
+	if result := UnescapeRegExp(`\d\.`); result != `\d.` {
+		t.Errorf("UnescapeRegExp: expected only metacharacters to be unescaped but got %q", result)
+	}
+}
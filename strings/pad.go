@@ -0,0 +1,278 @@
+package strings
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultOmission is the marker Truncate appends when no omission is configured.
+const DefaultOmission = "..."
+
+// TruncateOptions configures Truncate.
+type TruncateOptions struct {
+	// Omission is appended to truncated strings. It defaults to DefaultOmission.
+	Omission string
+	// NoOmission truncates without appending any marker, ignoring Omission.
+	NoOmission bool
+	// WordBoundary truncates at the last whitespace that fits, so that words are not cut in half.
+	WordBoundary bool
+}
+
+// wideRanges lists the code points that terminals render two columns wide:
+// East Asian wide and fullwidth characters, and emoji presentation symbols.
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F},
+	{0x231A, 0x231B},
+	{0x2329, 0x232A},
+	{0x23E9, 0x23EC},
+	{0x23F0, 0x23F0},
+	{0x23F3, 0x23F3},
+	{0x25FD, 0x25FE},
+	{0x2614, 0x2615},
+	{0x2648, 0x2653},
+	{0x267F, 0x267F},
+	{0x2693, 0x2693},
+	{0x26A1, 0x26A1},
+	{0x26AA, 0x26AB},
+	{0x26BD, 0x26BE},
+	{0x26C4, 0x26C5},
+	{0x26CE, 0x26CE},
+	{0x26D4, 0x26D4},
+	{0x26EA, 0x26EA},
+	{0x26F2, 0x26F3},
+	{0x26F5, 0x26F5},
+	{0x26FA, 0x26FA},
+	{0x26FD, 0x26FD},
+	{0x2705, 0x2705},
+	{0x270A, 0x270B},
+	{0x2728, 0x2728},
+	{0x274C, 0x274C},
+	{0x274E, 0x274E},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2795, 0x2797},
+	{0x27B0, 0x27B0},
+	{0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50},
+	{0x2B55, 0x2B55},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xA960, 0xA97F},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x16FE0, 0x16FE4},
+	{0x17000, 0x18CFF},
+	{0x1B000, 0x1B2FF},
+	{0x1F004, 0x1F004},
+	{0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A},
+	{0x1F1E6, 0x1F1FF},
+	{0x1F200, 0x1F251},
+	{0x1F300, 0x1F64F},
+	{0x1F680, 0x1F6FF},
+	{0x1F7E0, 0x1F7EB},
+	{0x1F90C, 0x1F9FF},
+	{0x1FA70, 0x1FAFF},
+	{0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// Pad pads s on both sides with chars until it is length columns wide.
+// When the padding cannot be split evenly, the right side gets the extra column.
+// Chars defaults to a single space.
+func Pad(s string, length int, chars string) string {
+	missing := length - Width(s)
+	if missing <= 0 {
+		return s
+	}
+	left := missing / 2
+	return padding(chars, left) + s + padding(chars, missing-left)
+}
+
+// PadEnd pads s on the right side with chars until it is length columns wide.
+// Chars defaults to a single space.
+func PadEnd(s string, length int, chars string) string {
+	return s + padding(chars, length-Width(s))
+}
+
+// PadStart pads s on the left side with chars until it is length columns wide.
+// Chars defaults to a single space.
+func PadStart(s string, length int, chars string) string {
+	return padding(chars, length-Width(s)) + s
+}
+
+// Repeat returns s repeated n times. Unlike strings.Repeat, it returns an empty string for a negative n.
+func Repeat(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(s, n)
+}
+
+// Truncate shortens s so that, including the omission marker, it is at most length columns wide.
+// Strings that already fit are returned unchanged.
+func Truncate(s string, length int, opts TruncateOptions) string {
+	if Width(s) <= length {
+		return s
+	}
+
+	omission := opts.Omission
+	if opts.NoOmission {
+		omission = ""
+	} else if omission == "" {
+		omission = DefaultOmission
+	}
+
+	budget := length - Width(omission)
+	if budget <= 0 {
+		// Not even the omission fits, so it is cut to the width as well.
+		return omission[:fitWidth(omission, length)]
+	}
+
+	end := fitWidth(s, budget)
+	prefix := s[:end]
+
+	if opts.WordBoundary {
+		// The prefix already ends at a word boundary if the rune after it is whitespace.
+		if next, _ := utf8.DecodeRuneInString(s[end:]); !unicode.IsSpace(next) {
+			if cut := strings.LastIndexFunc(prefix, unicode.IsSpace); cut > 0 {
+				prefix = prefix[:cut]
+			}
+		}
+		prefix = strings.TrimRightFunc(prefix, unicode.IsSpace)
+	}
+
+	return prefix + omission
+}
+
+// Width returns the number of terminal columns s occupies.
+// East Asian wide characters and emoji count as two columns, combining marks and
+// other zero-width runes count as none, and emoji joined to the previous rune by a
+// zero-width joiner do not add to the width of the sequence. A pair of regional indicators
+// forms a single two-column flag.
+func Width(s string) int {
+	width := 0
+	joined, flag := false, false
+	for _, r := range s {
+		if joined {
+			joined = false
+			if isEmoji(r) {
+				continue
+			}
+		}
+		if r == zeroWidthJoiner {
+			joined = true
+			continue
+		}
+		if isRegionalIndicator(r) {
+			// The second indicator of a pair completes the flag started by the first.
+			flag = !flag
+			if !flag {
+				continue
+			}
+		} else {
+			flag = false
+		}
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// fitWidth returns the length in bytes of the longest prefix of s that is at most width columns wide.
+func fitWidth(s string, width int) int {
+	end, used := 0, 0
+	for i, r := range s {
+		w := runeWidth(r)
+		if used+w > width {
+			break
+		}
+		used += w
+		end = i + utf8.RuneLen(r)
+	}
+	return end
+}
+
+// padding builds a string of exactly width columns by repeating chars.
+// If a wide rune of chars does not fit in the last column, a space is used instead.
+func padding(chars string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if chars == "" {
+		chars = " "
+	}
+
+	var b strings.Builder
+	for width > 0 {
+		progressed := false
+		for _, r := range chars {
+			w := runeWidth(r)
+			if w > width {
+				break
+			}
+			b.WriteRune(r)
+			width -= w
+			progressed = progressed || w > 0
+			if width == 0 {
+				break
+			}
+		}
+		if !progressed {
+			b.WriteString(strings.Repeat(" ", width))
+			break
+		}
+	}
+	return b.String()
+}
+
+// isEmoji reports whether r is a wide rune or a symbol that can take part in an emoji sequence,
+// such as the heart in "👨‍❤️‍👨".
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x2300 && r <= 0x23FF, r >= 0x2600 && r <= 0x27BF, r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	case r >= 0x1F000 && r <= 0x1FAFF:
+		return true
+	}
+	return runeWidth(r) == 2
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return 0
+	case r == zeroWidthJoiner || r == '\u200b' || r == '\ufeff':
+		return 0
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	for _, wide := range wideRanges {
+		if r < wide.lo {
+			break
+		}
+		if r <= wide.hi {
+			return 2
+		}
+	}
+	return 1
+}
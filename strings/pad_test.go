@@ -0,0 +1,260 @@
+package strings
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			name:     "ascii",
+			input:    "hello",
+			expected: 5,
+		},
+		{
+			name:     "cjk",
+			input:    "日本語",
+			expected: 6,
+		},
+		{
+			name:     "hangul and ascii",
+			input:    "a한b",
+			expected: 4,
+		},
+		{
+			name:     "emoji",
+			input:    "🙂!",
+			expected: 3,
+		},
+		{
+			name:     "combining mark",
+			input:    "é",
+			expected: 1,
+		},
+		{
+			name:     "zero width joiner sequence",
+			input:    "👨‍👩‍👧",
+			expected: 2,
+		},
+		{
+			name:     "skin tone modifier",
+			input:    "👍🏽",
+			expected: 2,
+		},
+		{
+			name:     "joined symbol in emoji sequence",
+			input:    "👨‍❤️‍👨",
+			expected: 2,
+		},
+		{
+			name:     "flag",
+			input:    "🇺🇸",
+			expected: 2,
+		},
+		{
+			name:     "adjacent flags and a lone indicator",
+			input:    "🇯🇵🇫🇷🇩",
+			expected: 6,
+		},
+		{
+			name:     "zero width joiner between letters",
+			input:    "a\u200db",
+			expected: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Width(testCase.input); result != testCase.expected {
+				t.Errorf("expected %d but got %d", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		length        int
+		chars         string
+		expected      string
+		expectedStart string
+		expectedEnd   string
+	}{
+		{
+			name:          "default chars",
+			input:         "abc",
+			length:        8,
+			chars:         "",
+			expected:      "  abc   ",
+			expectedStart: "     abc",
+			expectedEnd:   "abc     ",
+		},
+		{
+			name:          "custom chars",
+			input:         "abc",
+			length:        8,
+			chars:         "_-",
+			expected:      "_-abc_-_",
+			expectedStart: "_-_-_abc",
+			expectedEnd:   "abc_-_-_",
+		},
+		{
+			name:          "wide input",
+			input:         "日本",
+			length:        6,
+			chars:         ".",
+			expected:      ".日本.",
+			expectedStart: "..日本",
+			expectedEnd:   "日本..",
+		},
+		{
+			name:          "flag",
+			input:         "🇺🇸",
+			length:        4,
+			chars:         ".",
+			expected:      ".🇺🇸.",
+			expectedStart: "..🇺🇸",
+			expectedEnd:   "🇺🇸..",
+		},
+		{
+			name:          "wide chars fall back to spaces",
+			input:         "ab",
+			length:        5,
+			chars:         "日",
+			expected:      " ab日",
+			expectedStart: "日 ab",
+			expectedEnd:   "ab日 ",
+		},
+		{
+			name:          "already long enough",
+			input:         "abcdef",
+			length:        3,
+			chars:         "",
+			expected:      "abcdef",
+			expectedStart: "abcdef",
+			expectedEnd:   "abcdef",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Pad(testCase.input, testCase.length, testCase.chars); result != testCase.expected {
+				t.Errorf("Pad: expected %q but got %q", testCase.expected, result)
+			}
+			if result := PadStart(testCase.input, testCase.length, testCase.chars); result != testCase.expectedStart {
+				t.Errorf("PadStart: expected %q but got %q", testCase.expectedStart, result)
+			}
+			if result := PadEnd(testCase.input, testCase.length, testCase.chars); result != testCase.expectedEnd {
+				t.Errorf("PadEnd: expected %q but got %q", testCase.expectedEnd, result)
+			}
+		})
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+
+	if result := Repeat("ab", 3); result != "ababab" {
+		t.Errorf("expected ababab but got %q", result)
+	}
+	if result := Repeat("ab", -1); result != "" {
+		t.Errorf("expected an empty string but got %q", result)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	input := "hi-diddly-ho there, neighborino"
+
+	testCases := []struct {
+		name     string
+		input    string
+		length   int
+		opts     TruncateOptions
+		expected string
+	}{
+		{
+			name:     "default omission",
+			input:    input,
+			length:   24,
+			expected: "hi-diddly-ho there, n...",
+		},
+		{
+			name:     "word boundary",
+			input:    input,
+			length:   24,
+			opts:     TruncateOptions{WordBoundary: true},
+			expected: "hi-diddly-ho there,...",
+		},
+		{
+			name:     "custom omission",
+			input:    input,
+			length:   10,
+			opts:     TruncateOptions{Omission: " [...]"},
+			expected: "hi-d [...]",
+		},
+		{
+			name:     "fits",
+			input:    "short",
+			length:   10,
+			expected: "short",
+		},
+		{
+			name:     "wide characters",
+			input:    "日本語のテキスト",
+			length:   9,
+			opts:     TruncateOptions{Omission: "…"},
+			expected: "日本語の…",
+		},
+		{
+			name:     "no omission",
+			input:    input,
+			length:   12,
+			opts:     TruncateOptions{Omission: "…", NoOmission: true},
+			expected: "hi-diddly-ho",
+		},
+		{
+			name:     "no omission at word boundary",
+			input:    input,
+			length:   15,
+			opts:     TruncateOptions{NoOmission: true, WordBoundary: true},
+			expected: "hi-diddly-ho",
+		},
+		{
+			name:     "word boundary right after prefix",
+			input:    "hello world foo",
+			length:   14,
+			opts:     TruncateOptions{WordBoundary: true},
+			expected: "hello world...",
+		},
+		{
+			name:     "length shorter than omission",
+			input:    input,
+			length:   2,
+			expected: "..",
+		},
+		{
+			name:     "zero length",
+			input:    input,
+			length:   0,
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Truncate(testCase.input, testCase.length, testCase.opts); result != testCase.expected {
+				t.Errorf("expected %q but got %q", testCase.expected, result)
+			}
+		})
+	}
+}
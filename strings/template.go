@@ -0,0 +1,75 @@
+package strings
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	fusion "github.com/vatsalpatel/gofusion"
+)
+
+var placeholder = regexp.MustCompile(`\$\{\s*([^{}\s]+)\s*\}`)
+
+// Template replaces "${path}" placeholders in s with values from data.
+// A path is a dot-separated list of map keys, exported struct field names and slice indexes,
+// such as "${user.addresses.0.city}". Values are formatted like fusion.Join formats elements.
+// Placeholders whose path cannot be resolved are left unchanged.
+func Template(s string, data map[string]interface{}) string {
+	return placeholder.ReplaceAllStringFunc(s, func(match string) string {
+		path := placeholder.FindStringSubmatch(match)[1]
+		value, ok := lookupPath(data, strings.Split(path, "."))
+		if !ok {
+			return match
+		}
+		return fusion.ToString(value)
+	})
+}
+
+// lookupPath resolves a path of keys, field names and indexes against value.
+func lookupPath(value interface{}, path []string) (interface{}, bool) {
+	current := reflect.ValueOf(value)
+	for _, key := range path {
+		for current.Kind() == reflect.Interface || current.Kind() == reflect.Pointer {
+			if current.IsNil() {
+				return nil, false
+			}
+			current = current.Elem()
+		}
+
+		switch current.Kind() {
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			next := current.MapIndex(reflect.ValueOf(key).Convert(current.Type().Key()))
+			if !next.IsValid() {
+				return nil, false
+			}
+			current = next
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= current.Len() {
+				return nil, false
+			}
+			current = current.Index(index)
+		case reflect.Struct:
+			field, ok := current.Type().FieldByName(key)
+			if !ok || !field.IsExported() {
+				return nil, false
+			}
+			next, err := current.FieldByIndexErr(field.Index)
+			if err != nil {
+				return nil, false
+			}
+			current = next
+		default:
+			return nil, false
+		}
+	}
+
+	if !current.IsValid() {
+		return nil, false
+	}
+	return current.Interface(), true
+}
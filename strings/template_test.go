@@ -0,0 +1,67 @@
+package strings
+
+import "testing"
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		City string
+	}
+
+	data := map[string]interface{}{
+		"name":  "Ann",
+		"count": 3,
+		"user": map[string]interface{}{
+			"email": "ann@example.com",
+			"tags":  []string{"admin", "ops"},
+		},
+		"address": &address{City: "Oslo"},
+		"scores":  map[string]float64{"math": 9.5},
+	}
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "top-level values",
+			input:    "Hello ${name}, you have ${count} messages",
+			expected: "Hello Ann, you have 3 messages",
+		},
+		{
+			name:     "nested paths",
+			input:    "${user.email} is ${user.tags.0}",
+			expected: "ann@example.com is admin",
+		},
+		{
+			name:     "struct fields and typed maps",
+			input:    "${address.City}: ${scores.math}",
+			expected: "Oslo: 9.5",
+		},
+		{
+			name:     "whitespace inside braces",
+			input:    "${ name }",
+			expected: "Ann",
+		},
+		{
+			name:     "unresolved placeholders are kept",
+			input:    "${missing} ${user.tags.5} ${address.Zip}",
+			expected: "${missing} ${user.tags.5} ${address.Zip}",
+		},
+		{
+			name:     "slice values use Join formatting",
+			input:    "${user.tags}",
+			expected: "[admin ops]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Template(testCase.input, data); result != testCase.expected {
+				t.Errorf("expected %q but got %q", testCase.expected, result)
+			}
+		})
+	}
+}
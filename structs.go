@@ -0,0 +1,434 @@
+package fusion
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotStruct is returned when a struct conversion is given a value that is not a struct or a pointer to one.
+var ErrNotStruct = errors.New("fusion: value is not a struct")
+
+// StructOptions configures conversions between structs and maps.
+type StructOptions struct {
+	// TagName is the struct tag that holds the map key of each field, such as `json:"name,omitempty"`.
+	// It defaults to "json". Fields without the tag use their Go name, and fields tagged "-" are skipped.
+	TagName string
+}
+
+// FieldError describes why a map value could not be stored in a struct field.
+type FieldError struct {
+	// Field is the dotted path of the field, using map keys.
+	Field string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("field %s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MapToStruct creates a T from a map, matching keys to fields by their json tag or Go name.
+// Values are converted weakly where possible, so the string "42" fills an int field and a nested map fills a struct.
+// It reports every field that could not be set, joined into one error of *FieldError values.
+func MapToStruct[T any](m map[string]interface{}) (T, error) {
+	return MapToStructWith[T](m, StructOptions{})
+}
+
+// MapToStructWith is like MapToStruct, but reads field names from the tag configured in opts.
+func MapToStructWith[T any](m map[string]interface{}, opts StructOptions) (T, error) {
+	var result T
+	target := reflect.ValueOf(&result).Elem()
+	if target.Kind() != reflect.Struct {
+		return result, ErrNotStruct
+	}
+
+	var errs []error
+	decodeStruct(target, m, opts.tagName(), "", &errs)
+	return result, errors.Join(errs...)
+}
+
+// OmitFields converts a struct into a map like StructToMap, leaving out the given keys.
+func OmitFields(v interface{}, keys ...string) (map[string]interface{}, error) {
+	m, err := StructToMap(v, StructOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return Omit(m, keys...), nil
+}
+
+// PickFields converts a struct into a map like StructToMap, keeping only the given keys.
+func PickFields(v interface{}, keys ...string) (map[string]interface{}, error) {
+	m, err := StructToMap(v, StructOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return Pick(m, keys...), nil
+}
+
+// StructToMap converts the exported fields of a struct, or a pointer to one, into a map.
+// Keys are taken from the tag configured in opts, and fields tagged omitempty are left out when empty.
+// The fields of embedded structs without a tag name are promoted into the map, as encoding/json does.
+func StructToMap(v interface{}, opts StructOptions) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, ErrNotStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	result := make(map[string]interface{})
+	encodeStruct(rv, opts.tagName(), result)
+	return result, nil
+}
+
+func (opts StructOptions) tagName() string {
+	if opts.TagName == "" {
+		return "json"
+	}
+	return opts.TagName
+}
+
+// structField is an exported field of a struct together with the options parsed from its tag.
+type structField struct {
+	index     int
+	name      string
+	omitEmpty bool
+	embedded  bool
+}
+
+// structFields lists the fields of a struct type that take part in map conversions.
+func structFields(t reflect.Type, tagName string) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, structField{index: i, embedded: true})
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, structField{
+			index:     i,
+			name:      name,
+			omitEmpty: strings.Contains(","+options+",", ",omitempty,"),
+		})
+	}
+	return fields
+}
+
+func encodeStruct(rv reflect.Value, tagName string, result map[string]interface{}) {
+	var embedded []reflect.Value
+	for _, field := range structFields(rv.Type(), tagName) {
+		value := rv.Field(field.index)
+		if field.embedded {
+			if value.Kind() == reflect.Pointer {
+				if value.IsNil() {
+					continue
+				}
+				value = value.Elem()
+			}
+			embedded = append(embedded, value)
+			continue
+		}
+		if field.omitEmpty && isEmptyValue(value) {
+			continue
+		}
+		result[field.name] = value.Interface()
+	}
+
+	// Fields of the outer struct take precedence over promoted fields with the same name.
+	for _, value := range embedded {
+		promoted := make(map[string]interface{})
+		encodeStruct(value, tagName, promoted)
+		for key, v := range promoted {
+			if _, exists := result[key]; !exists {
+				result[key] = v
+			}
+		}
+	}
+}
+
+func decodeStruct(target reflect.Value, m map[string]interface{}, tagName, path string, errs *[]error) {
+	for _, field := range structFields(target.Type(), tagName) {
+		dst := target.Field(field.index)
+		if field.embedded {
+			if dst.Kind() == reflect.Pointer {
+				if !dst.CanSet() {
+					continue
+				}
+				if dst.IsNil() {
+					dst.Set(reflect.New(dst.Type().Elem()))
+				}
+				dst = dst.Elem()
+			}
+			decodeStruct(dst, m, tagName, path, errs)
+			continue
+		}
+
+		value, ok := m[field.name]
+		if !ok {
+			continue
+		}
+		fieldPath := path + field.name
+		if err := coerce(dst, value, tagName, fieldPath+".", errs); err != nil {
+			*errs = append(*errs, &FieldError{Field: fieldPath, Err: err})
+		}
+	}
+}
+
+// coerce stores src into dst, converting between compatible representations.
+// Errors inside nested structs are appended to errs with their full path; other errors are returned.
+func coerce(dst reflect.Value, src interface{}, tagName, path string, errs *[]error) error {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+
+	if dst.Type() == timeType {
+		if s, ok := src.(string); ok {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return err
+			}
+			dst.Set(reflect.ValueOf(t))
+			return nil
+		}
+	}
+
+	text, isText := src.(string)
+	switch dst.Kind() {
+	case reflect.String:
+		switch sv.Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64, reflect.String:
+			dst.SetString(fmt.Sprintf("%v", src))
+			return nil
+		case reflect.Slice:
+			if b, ok := src.([]byte); ok {
+				dst.SetString(string(b))
+				return nil
+			}
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch {
+		case isText:
+			parsed, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+			if err != nil {
+				return fmt.Errorf("cannot parse %q as %s", text, dst.Type())
+			}
+			n = parsed
+		case sv.CanInt():
+			n = sv.Int()
+		case sv.CanUint():
+			if sv.Uint() > math.MaxInt64 {
+				return fmt.Errorf("value %v overflows %s", src, dst.Type())
+			}
+			n = int64(sv.Uint())
+		case sv.CanFloat():
+			f := sv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return fmt.Errorf("cannot convert %v to %s without losing precision", src, dst.Type())
+			}
+			n = int64(f)
+		case sv.Kind() == reflect.Bool:
+			if sv.Bool() {
+				n = 1
+			}
+		default:
+			return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+		}
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("value %v overflows %s", src, dst.Type())
+		}
+		dst.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		switch {
+		case isText:
+			parsed, err := strconv.ParseUint(strings.TrimSpace(text), 10, 64)
+			if err != nil {
+				return fmt.Errorf("cannot parse %q as %s", text, dst.Type())
+			}
+			n = parsed
+		case sv.CanUint():
+			n = sv.Uint()
+		case sv.CanInt():
+			if sv.Int() < 0 {
+				return fmt.Errorf("negative value %v cannot be stored in %s", src, dst.Type())
+			}
+			n = uint64(sv.Int())
+		case sv.CanFloat():
+			f := sv.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				return fmt.Errorf("cannot convert %v to %s without losing precision", src, dst.Type())
+			}
+			n = uint64(f)
+		case sv.Kind() == reflect.Bool:
+			if sv.Bool() {
+				n = 1
+			}
+		default:
+			return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+		}
+		if dst.OverflowUint(n) {
+			return fmt.Errorf("value %v overflows %s", src, dst.Type())
+		}
+		dst.SetUint(n)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case isText:
+			f, err := strconv.ParseFloat(strings.TrimSpace(text), dst.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("cannot parse %q as %s", text, dst.Type())
+			}
+			dst.SetFloat(f)
+		case sv.CanFloat():
+			dst.SetFloat(sv.Float())
+		case sv.CanInt():
+			dst.SetFloat(float64(sv.Int()))
+		case sv.CanUint():
+			dst.SetFloat(float64(sv.Uint()))
+		default:
+			return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+		}
+		return nil
+
+	case reflect.Bool:
+		switch {
+		case isText:
+			b, err := strconv.ParseBool(strings.TrimSpace(text))
+			if err != nil {
+				return fmt.Errorf("cannot parse %q as %s", text, dst.Type())
+			}
+			dst.SetBool(b)
+		case sv.CanInt():
+			dst.SetBool(sv.Int() != 0)
+		case sv.CanUint():
+			dst.SetBool(sv.Uint() != 0)
+		case sv.CanFloat():
+			dst.SetBool(sv.Float() != 0)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+		}
+		return nil
+
+	case reflect.Slice:
+		if isText && dst.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetBytes([]byte(text))
+			return nil
+		}
+		if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+			sv = reflect.ValueOf([]interface{}{src})
+		}
+		slice := reflect.MakeSlice(dst.Type(), sv.Len(), sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			if err := coerce(slice.Index(i), sv.Index(i).Interface(), tagName, path+strconv.Itoa(i)+".", errs); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		dst.Set(slice)
+		return nil
+
+	case reflect.Map:
+		if sv.Kind() != reflect.Map {
+			break
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), sv.Len())
+		iter := sv.MapRange()
+		for iter.Next() {
+			key := reflect.New(dst.Type().Key()).Elem()
+			if err := coerce(key, iter.Key().Interface(), tagName, path, errs); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			value := reflect.New(dst.Type().Elem()).Elem()
+			if err := coerce(value, iter.Value().Interface(), tagName, path+fmt.Sprint(iter.Key())+".", errs); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			m.SetMapIndex(key, value)
+		}
+		dst.Set(m)
+		return nil
+
+	case reflect.Struct:
+		nested, ok := src.(map[string]interface{})
+		if !ok {
+			break
+		}
+		decodeStruct(dst, nested, tagName, path, errs)
+		return nil
+
+	case reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := coerce(elem.Elem(), src, tagName, path, errs); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	if sv.Type().ConvertibleTo(dst.Type()) && sv.Kind() == dst.Kind() {
+		dst.Set(sv.Convert(dst.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+}
+
+// isEmptyValue reports whether a field tagged omitempty should be left out, using the rules of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
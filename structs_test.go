@@ -0,0 +1,236 @@
+package fusion
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+type structBase struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type structAddress struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+type structUser struct {
+	structBase
+	Name     string            `json:"name" db:"user_name"`
+	Email    string            `json:"email,omitempty"`
+	Age      int               `json:"age"`
+	Active   bool              `json:"active"`
+	Score    float64           `json:"score,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	Address  structAddress     `json:"address"`
+	Manager  *structAddress    `json:"manager,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Password string            `json:"-"`
+	Nickname string
+	internal string
+}
+
+func TestStructToMap(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := structUser{
+		structBase: structBase{ID: 7, CreatedAt: created},
+		Name:       "ann",
+		Age:        31,
+		Tags:       []string{"admin"},
+		Address:    structAddress{City: "Oslo", Zip: 150},
+		Password:   "secret",
+		Nickname:   "annie",
+		internal:   "hidden",
+	}
+
+	expected := map[string]interface{}{
+		"id":         7,
+		"created_at": created,
+		"name":       "ann",
+		"age":        31,
+		"active":     false,
+		"tags":       []string{"admin"},
+		"address":    structAddress{City: "Oslo", Zip: 150},
+		"Nickname":   "annie",
+	}
+
+	result, err := StructToMap(&user, StructOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+
+	result, err = StructToMap(user, StructOptions{TagName: "db"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result["user_name"] != "ann" || result["Email"] != "" || result["Password"] != "secret" {
+		t.Errorf("expected the db tag to be used but got %v", result)
+	}
+
+	if _, err := StructToMap(42, StructOptions{}); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct but got %v", err)
+	}
+	if _, err := StructToMap((*structUser)(nil), StructOptions{}); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct for a nil pointer but got %v", err)
+	}
+}
+
+func TestStructToMapPrecedence(t *testing.T) {
+	t.Parallel()
+
+	type inner struct {
+		Name  string `json:"name"`
+		Inner bool   `json:"inner"`
+	}
+	type outer struct {
+		*inner
+		Name string `json:"name"`
+	}
+
+	result, err := StructToMap(outer{inner: &inner{Name: "inner", Inner: true}, Name: "outer"}, StructOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]interface{}{"name": "outer", "inner": true}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v but got %v", expected, result)
+	}
+}
+
+func TestMapToStruct(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"id":         "42",
+		"created_at": "2024-01-01T00:00:00Z",
+		"name":       "ann",
+		"age":        float64(31),
+		"active":     "true",
+		"score":      "9.5",
+		"tags":       []interface{}{"a", 1},
+		"address":    map[string]interface{}{"city": "Oslo", "zip": "150"},
+		"manager":    map[string]interface{}{"city": "Bergen"},
+		"labels":     map[string]interface{}{"team": "core"},
+		"Nickname":   "annie",
+		"unknown":    "ignored",
+	}
+
+	result, err := MapToStruct[structUser](input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := structUser{
+		structBase: structBase{ID: 42, CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Name:       "ann",
+		Age:        31,
+		Active:     true,
+		Score:      9.5,
+		Tags:       []string{"a", "1"},
+		Address:    structAddress{City: "Oslo", Zip: 150},
+		Manager:    &structAddress{City: "Bergen"},
+		Labels:     map[string]string{"team": "core"},
+		Nickname:   "annie",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v but got %+v", expected, result)
+	}
+
+	tagged, err := MapToStructWith[structUser](map[string]interface{}{"user_name": "bob"}, StructOptions{TagName: "db"})
+	if err != nil || tagged.Name != "bob" {
+		t.Errorf("expected the db tag to be used but got %+v (%v)", tagged, err)
+	}
+
+	if _, err := MapToStruct[int](input); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct but got %v", err)
+	}
+}
+
+func TestMapToStructErrors(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"id":      "abc",
+		"age":     3.5,
+		"active":  []int{1},
+		"address": map[string]interface{}{"zip": "north"},
+		"name":    "still set",
+	}
+
+	result, err := MapToStruct[structUser](input)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if result.Name != "still set" {
+		t.Errorf("expected valid fields to be set despite errors but got %+v", result)
+	}
+
+	var fields []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fieldErr *FieldError
+		if !errors.As(e, &fieldErr) {
+			t.Fatalf("expected a *FieldError but got %T", e)
+		}
+		fields = append(fields, fieldErr.Field)
+	}
+	sort.Strings(fields)
+
+	if expected := []string{"active", "address.zip", "age", "id"}; !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected errors for %v but got %v", expected, fields)
+	}
+	if !strings.Contains(err.Error(), `field id: cannot parse "abc" as int`) {
+		t.Errorf("expected a descriptive message but got %q", err.Error())
+	}
+}
+
+func TestMapToStructOverflow(t *testing.T) {
+	t.Parallel()
+
+	type small struct {
+		Value int8
+		Count uint
+	}
+
+	_, err := MapToStruct[small](map[string]interface{}{"Value": 300, "Count": -1})
+	if err == nil {
+		t.Fatalf("expected overflow and sign errors")
+	}
+	if !strings.Contains(err.Error(), "overflows int8") || !strings.Contains(err.Error(), "negative value") {
+		t.Errorf("unexpected error message %q", err.Error())
+	}
+}
+
+func TestPickOmitFields(t *testing.T) {
+	t.Parallel()
+
+	user := structUser{Name: "ann", Age: 31, Address: structAddress{City: "Oslo"}}
+
+	picked, err := PickFields(user, "name", "age", "missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]interface{}{"name": "ann", "age": 31}; !reflect.DeepEqual(picked, expected) {
+		t.Errorf("PickFields: expected %v but got %v", expected, picked)
+	}
+
+	omitted, err := OmitFields(&user, "address", "created_at", "id", "Nickname", "active")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]interface{}{"name": "ann", "age": 31}; !reflect.DeepEqual(omitted, expected) {
+		t.Errorf("OmitFields: expected %v but got %v", expected, omitted)
+	}
+
+	if _, err := PickFields("not a struct", "name"); !errors.Is(err, ErrNotStruct) {
+		t.Errorf("expected ErrNotStruct but got %v", err)
+	}
+}
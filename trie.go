@@ -0,0 +1,214 @@
+package fusion
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Trie is a radix tree mapping string keys to values. Chains of nodes with a single child are stored
+// as one edge, and edges are only split between runes, never inside a multi-byte UTF-8 sequence.
+// The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+type trieNode[V any] struct {
+	prefix   string
+	value    V
+	hasValue bool
+	// children are sorted by prefix, and no two of them start with the same rune.
+	children []*trieNode[V]
+}
+
+// NewTrie returns an empty trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Trie[V]) Delete(key string) bool {
+	if !t.root.delete(key) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+// Get returns the value stored under key, or false if key is not present.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	node := &t.root
+	for key != "" {
+		_, child := node.child(key)
+		if child == nil || !strings.HasPrefix(key, child.prefix) {
+			var zero V
+			return zero, false
+		}
+		node, key = child, key[len(child.prefix):]
+	}
+	return node.value, node.hasValue
+}
+
+// Insert stores value under key, replacing any previous value.
+func (t *Trie[V]) Insert(key string, value V) {
+	node := &t.root
+	for key != "" {
+		i, child := node.child(key)
+		if child == nil {
+			leaf := &trieNode[V]{prefix: key, value: value, hasValue: true}
+			node.children = append(node.children, nil)
+			copy(node.children[i+1:], node.children[i:])
+			node.children[i] = leaf
+			t.size++
+			return
+		}
+
+		n := commonPrefixLength(child.prefix, key)
+		if n < len(child.prefix) {
+			split := &trieNode[V]{prefix: child.prefix[:n], children: []*trieNode[V]{child}}
+			child.prefix = child.prefix[n:]
+			node.children[i] = split
+			child = split
+		}
+		node, key = child, key[n:]
+	}
+
+	if !node.hasValue {
+		t.size++
+	}
+	node.value, node.hasValue = value, true
+}
+
+// Len returns the number of keys in the trie.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// LongestPrefixOf returns the longest key in the trie that is a prefix of s, and its value.
+// It returns false if no key is a prefix of s.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	var found *trieNode[V]
+	var length int
+	node, consumed := &t.root, 0
+	for {
+		if node.hasValue {
+			found, length = node, consumed
+		}
+		rest := s[consumed:]
+		if rest == "" {
+			break
+		}
+		_, child := node.child(rest)
+		if child == nil || !strings.HasPrefix(rest, child.prefix) {
+			break
+		}
+		node, consumed = child, consumed+len(child.prefix)
+	}
+
+	if found == nil {
+		var zero V
+		return "", zero, false
+	}
+	return s[:length], found.value, true
+}
+
+// WithPrefix calls fn in lexical order for each key that starts with prefix, until fn returns false.
+func (t *Trie[V]) WithPrefix(prefix string, fn func(key string, value V) bool) {
+	node, rest := &t.root, prefix
+	var path strings.Builder
+	for rest != "" {
+		_, child := node.child(rest)
+		switch {
+		case child == nil:
+			return
+		case strings.HasPrefix(rest, child.prefix):
+			rest = rest[len(child.prefix):]
+		case strings.HasPrefix(child.prefix, rest):
+			rest = ""
+		default:
+			return
+		}
+		path.WriteString(child.prefix)
+		node = child
+	}
+	node.walk(path.String(), fn)
+}
+
+// child returns the child whose prefix starts with the same rune as key, or nil and the index
+// at which such a child would be inserted.
+func (n *trieNode[V]) child(key string) (int, *trieNode[V]) {
+	first := firstRune(key)
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix >= first
+	})
+	if i < len(n.children) && firstRune(n.children[i].prefix) == first {
+		return i, n.children[i]
+	}
+	return i, nil
+}
+
+// delete removes key from the subtree below n and reports whether it was present,
+// pruning and merging nodes that are no longer needed.
+func (n *trieNode[V]) delete(key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zero V
+		n.value, n.hasValue = zero, false
+		return true
+	}
+
+	i, child := n.child(key)
+	if child == nil || !strings.HasPrefix(key, child.prefix) || !child.delete(key[len(child.prefix):]) {
+		return false
+	}
+
+	switch {
+	case child.hasValue:
+	case len(child.children) == 0:
+		last := len(n.children) - 1
+		copy(n.children[i:], n.children[i+1:])
+		n.children[last] = nil
+		n.children = n.children[:last]
+	case len(child.children) == 1:
+		grandchild := child.children[0]
+		grandchild.prefix = child.prefix + grandchild.prefix
+		n.children[i] = grandchild
+	}
+	return true
+}
+
+// walk calls fn for the values of the subtree below n in lexical order and reports whether to continue.
+func (n *trieNode[V]) walk(key string, fn func(string, V) bool) bool {
+	if n.hasValue && !fn(key, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(key+child.prefix, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// commonPrefixLength returns the length in bytes of the longest common prefix of a and b
+// that ends on a rune boundary. Invalid UTF-8 is compared one byte at a time.
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) {
+		_, size := utf8.DecodeRuneInString(a[n:])
+		if !strings.HasPrefix(b[n:], a[n:n+size]) {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// firstRune returns the encoding of the first rune of s, or its first byte if s does not start with valid UTF-8.
+func firstRune(s string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size]
+}
@@ -0,0 +1,205 @@
+package fusion
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"unicode/utf8"
+)
+
+// trieKeys collects the keys of t that start with prefix.
+func trieKeys[V any](t *Trie[V], prefix string) []string {
+	keys := make([]string, 0)
+	t.WithPrefix(prefix, func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestTrie(t *testing.T) {
+	t.Parallel()
+
+	var trie Trie[int]
+	words := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom"}
+	for i, word := range words {
+		trie.Insert(word, i)
+	}
+	trie.Insert("rom", 100)
+
+	if trie.Len() != len(words) {
+		t.Errorf("expected length %d but got %d", len(words), trie.Len())
+	}
+	if value, ok := trie.Get("rom"); !ok || value != 100 {
+		t.Errorf("expected 100 but got %d", value)
+	}
+	if _, ok := trie.Get("ro"); ok {
+		t.Errorf("expected the inner node ro to have no value")
+	}
+	if _, ok := trie.Get("romanesque"); ok {
+		t.Errorf("expected a missing key not to be found")
+	}
+
+	testCases := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{
+			name:     "edge boundary",
+			prefix:   "rom",
+			expected: []string{"rom", "romane", "romanus", "romulus"},
+		},
+		{
+			name:     "inside an edge",
+			prefix:   "rubic",
+			expected: []string{"rubicon", "rubicundus"},
+		},
+		{
+			name:     "all keys",
+			prefix:   "",
+			expected: []string{"rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"},
+		},
+		{
+			name:     "no match",
+			prefix:   "rx",
+			expected: []string{},
+		},
+		{
+			name:     "diverging inside an edge",
+			prefix:   "rubix",
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := trieKeys(&trie, testCase.prefix); !IsEqual(result, testCase.expected) {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	var first []string
+	trie.WithPrefix("r", func(key string, _ int) bool {
+		first = append(first, key)
+		return len(first) < 2
+	})
+	if expected := []string{"rom", "romane"}; !IsEqual(first, expected) {
+		t.Errorf("expected iteration to stop after %v but got %v", expected, first)
+	}
+}
+
+func TestTrieLongestPrefixOf(t *testing.T) {
+	t.Parallel()
+
+	trie := NewTrie[string]()
+	trie.Insert("/", "root")
+	trie.Insert("/api", "api")
+	trie.Insert("/api/users", "users")
+
+	testCases := []struct {
+		name     string
+		input    string
+		key      string
+		value    string
+		expected bool
+	}{
+		{name: "exact", input: "/api", key: "/api", value: "api", expected: true},
+		{name: "longer", input: "/api/users/42", key: "/api/users", value: "users", expected: true},
+		{name: "inside an edge", input: "/api/us", key: "/api", value: "api", expected: true},
+		{name: "root only", input: "/static", key: "/", value: "root", expected: true},
+		{name: "no prefix", input: "api", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			key, value, ok := trie.LongestPrefixOf(testCase.input)
+			if ok != testCase.expected || key != testCase.key || value != testCase.value {
+				t.Errorf("expected %q %q %v but got %q %q %v", testCase.key, testCase.value, testCase.expected, key, value, ok)
+			}
+		})
+	}
+}
+
+func TestTrieUnicode(t *testing.T) {
+	t.Parallel()
+
+	// "é" and "è" share their first UTF-8 byte, so a byte-wise trie would split inside the rune.
+	var trie Trie[bool]
+	for _, word := range []string{"café", "cafè", "cafe", "日本", "日本語", "日曜"} {
+		trie.Insert(word, true)
+	}
+
+	if result := trieKeys(&trie, "caf"); !IsEqual(result, []string{"cafe", "cafè", "café"}) {
+		t.Errorf("expected [cafe cafè café] but got %v", result)
+	}
+	if result := trieKeys(&trie, "日"); !IsEqual(result, []string{"日曜", "日本", "日本語"}) {
+		t.Errorf("expected [日曜 日本 日本語] but got %v", result)
+	}
+	if key, _, ok := trie.LongestPrefixOf("日本語の本"); !ok || key != "日本語" {
+		t.Errorf("expected 日本語 but got %q", key)
+	}
+
+	var check func(n *trieNode[bool])
+	check = func(n *trieNode[bool]) {
+		for _, child := range n.children {
+			if !utf8.ValidString(child.prefix) {
+				t.Errorf("expected edges to hold whole runes but got %q", child.prefix)
+			}
+			check(child)
+		}
+	}
+	check(&trie.root)
+}
+
+func TestTrieRandom(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "b", "é", "è", "日"}
+	randomKey := func() string {
+		key := ""
+		for i := rng.Intn(5); i > 0; i-- {
+			key += alphabet[rng.Intn(len(alphabet))]
+		}
+		return key
+	}
+
+	var trie Trie[int]
+	reference := make(map[string]int)
+	for step := 0; step < 3000; step++ {
+		key := randomKey()
+		if rng.Intn(3) == 0 {
+			_, expected := reference[key]
+			if deleted := trie.Delete(key); deleted != expected {
+				t.Fatalf("step %d: expected Delete(%q) to return %v", step, key, expected)
+			}
+			delete(reference, key)
+		} else {
+			trie.Insert(key, step)
+			reference[key] = step
+		}
+
+		if trie.Len() != len(reference) {
+			t.Fatalf("step %d: expected length %d but got %d", step, len(reference), trie.Len())
+		}
+		prefix := randomKey()
+		if result, expected := trieKeys(&trie, prefix), KeysWithPrefix(reference, prefix); !IsEqual(result, expected) {
+			t.Fatalf("step %d: expected keys with prefix %q %v but got %v", step, prefix, expected, result)
+		}
+		if value, ok := trie.Get(key); value != reference[key] || ok != Includes(Keys(reference), key) {
+			t.Fatalf("step %d: unexpected Get(%q) result %d %v", step, key, value, ok)
+		}
+	}
+
+	// Deleting everything leaves no nodes behind.
+	keys := Keys(reference)
+	sort.Strings(keys)
+	for _, key := range keys {
+		trie.Delete(key)
+	}
+	if trie.Len() != 0 || len(trie.root.children) != 0 {
+		t.Errorf("expected an empty trie but got %d keys and %d children", trie.Len(), len(trie.root.children))
+	}
+}
@@ -0,0 +1,153 @@
+// Package fusion is the v2 array API of GoFusion.
+//
+// Every callback-taking function comes in two forms that share one convention:
+// a simple form whose callback receives only the element, such as func(T) bool or func(T) U,
+// and an indexed form with an I suffix whose callback receives (index, value).
+// The simple forms compose directly with the helpers of the root package, such as Negate and Pipe2.
+package fusion
+
+// Every checks if all elements in the slice satisfy the given predicate.
+func Every[T any](arr []T, predicate func(T) bool) bool {
+	for _, value := range arr {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// EveryI is like Every, but the predicate is invoked with (index, value).
+func EveryI[T any](arr []T, predicate func(int, T) bool) bool {
+	for i, value := range arr {
+		if !predicate(i, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the elements of the slice for which the predicate returns true.
+func Filter[T any](arr []T, predicate func(T) bool) []T {
+	var result []T
+	for _, value := range arr {
+		if predicate(value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// FilterI is like Filter, but the predicate is invoked with (index, value).
+func FilterI[T any](arr []T, predicate func(int, T) bool) []T {
+	var result []T
+	for i, value := range arr {
+		if predicate(i, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// FindIndex returns the index of the first element that satisfies the predicate, or -1 if none does.
+func FindIndex[T any](arr []T, predicate func(T) bool) int {
+	for i, value := range arr {
+		if predicate(value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindIndexI is like FindIndex, but the predicate is invoked with (index, value).
+func FindIndexI[T any](arr []T, predicate func(int, T) bool) int {
+	for i, value := range arr {
+		if predicate(i, value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Map applies a transformation function to each element of the slice
+// and returns a new slice with the transformed values.
+func Map[T any, U any](arr []T, transform func(T) U) []U {
+	result := make([]U, len(arr))
+	for i, value := range arr {
+		result[i] = transform(value)
+	}
+	return result
+}
+
+// MapI is like Map, but the transformation function is invoked with (index, value).
+func MapI[T any, U any](arr []T, transform func(int, T) U) []U {
+	result := make([]U, len(arr))
+	for i, value := range arr {
+		result[i] = transform(i, value)
+	}
+	return result
+}
+
+// Reduce applies a function against an accumulator and each element in the slice (from left to right)
+// to reduce it to a single value. The function is invoked with (accumulator, value).
+func Reduce[T any, R any](arr []T, reducer func(R, T) R, initialValue R) R {
+	accumulator := initialValue
+	for _, value := range arr {
+		accumulator = reducer(accumulator, value)
+	}
+	return accumulator
+}
+
+// ReduceI is like Reduce, but the function is invoked with (accumulator, index, value).
+func ReduceI[T any, R any](arr []T, reducer func(R, int, T) R, initialValue R) R {
+	accumulator := initialValue
+	for i, value := range arr {
+		accumulator = reducer(accumulator, i, value)
+	}
+	return accumulator
+}
+
+// Remove removes all elements from the slice for which the predicate returns true,
+// and returns a slice of the removed elements.
+func Remove[T any](arr *[]T, predicate func(T) bool) []T {
+	return RemoveI(arr, func(_ int, value T) bool {
+		return predicate(value)
+	})
+}
+
+// RemoveI is like Remove, but the predicate is invoked with (index, value).
+// Indexes refer to positions in the slice before any element is removed.
+func RemoveI[T any](arr *[]T, predicate func(int, T) bool) []T {
+	var removed []T
+	remaining := (*arr)[:0]
+
+	for i, value := range *arr {
+		if predicate(i, value) {
+			removed = append(removed, value)
+		} else {
+			remaining = append(remaining, value)
+		}
+	}
+
+	*arr = remaining
+	return removed
+}
+
+// Some checks if at least one element in the slice satisfies the given predicate.
+func Some[T any](arr []T, predicate func(T) bool) bool {
+	for _, value := range arr {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// SomeI is like Some, but the predicate is invoked with (index, value).
+func SomeI[T any](arr []T, predicate func(int, T) bool) bool {
+	for i, value := range arr {
+		if predicate(i, value) {
+			return true
+		}
+	}
+	return false
+}
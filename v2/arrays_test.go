@@ -0,0 +1,175 @@
+package fusion
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	v1 "github.com/vatsalpatel/gofusion"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestEvery(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected bool
+	}{
+		{
+			name:     "all even numbers",
+			input:    []int{2, 4, 6},
+			expected: true,
+		},
+		{
+			name:     "some even numbers",
+			input:    []int{1, 2, 3},
+			expected: false,
+		},
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := Every(testCase.input, isEven); result != testCase.expected {
+				t.Errorf("expected %v but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	if !EveryI([]int{0, 1, 2}, func(i, value int) bool { return i == value }) {
+		t.Errorf("expected EveryI to match every index to its value")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3, 4, 5, 6}
+
+	if result, expected := Filter(input, isEven), []int{2, 4, 6}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Filter: expected %v but got %v", expected, result)
+	}
+
+	if result, expected := Filter(input, v1.Negate(isEven)), []int{1, 3, 5}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Filter with Negate: expected %v but got %v", expected, result)
+	}
+
+	result := FilterI(input, func(i, _ int) bool { return i < 2 })
+	if expected := []int{1, 2}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("FilterI: expected %v but got %v", expected, result)
+	}
+}
+
+func TestFindIndex(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected int
+	}{
+		{
+			name:     "match found",
+			input:    []int{1, 3, 4, 6},
+			expected: 2,
+		},
+		{
+			name:     "no match",
+			input:    []int{1, 3, 5},
+			expected: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := FindIndex(testCase.input, isEven); result != testCase.expected {
+				t.Errorf("expected %d but got %d", testCase.expected, result)
+			}
+		})
+	}
+
+	if result := FindIndexI([]int{5, 5, 5}, func(i, _ int) bool { return i == 1 }); result != 1 {
+		t.Errorf("FindIndexI: expected 1 but got %d", result)
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3}
+
+	if result, expected := Map(input, strconv.Itoa), []string{"1", "2", "3"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Map: expected %v but got %v", expected, result)
+	}
+
+	double := func(value int) int { return value * 2 }
+	if result, expected := Map(input, v1.Pipe2(double, strconv.Itoa)), []string{"2", "4", "6"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Map with Pipe2: expected %v but got %v", expected, result)
+	}
+
+	result := MapI(input, func(i, value int) int { return i * value })
+	if expected := []int{0, 2, 6}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapI: expected %v but got %v", expected, result)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3, 4}
+
+	sum := Reduce(input, func(acc, value int) int { return acc + value }, 0)
+	if sum != 10 {
+		t.Errorf("Reduce: expected 10 but got %d", sum)
+	}
+
+	weighted := ReduceI(input, func(acc, i, value int) int { return acc + i*value }, 0)
+	if weighted != 20 {
+		t.Errorf("ReduceI: expected 20 but got %d", weighted)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	arr := []int{1, 2, 3, 4, 5}
+	removed := Remove(&arr, isEven)
+	if expected := []int{2, 4}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("Remove: expected removed %v but got %v", expected, removed)
+	}
+	if expected := []int{1, 3, 5}; !reflect.DeepEqual(arr, expected) {
+		t.Errorf("Remove: expected remaining %v but got %v", expected, arr)
+	}
+
+	arr = []int{10, 20, 30, 40}
+	removed = RemoveI(&arr, func(i, _ int) bool { return i%2 == 1 })
+	if expected := []int{20, 40}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("RemoveI: expected removed %v but got %v", expected, removed)
+	}
+	if expected := []int{10, 30}; !reflect.DeepEqual(arr, expected) {
+		t.Errorf("RemoveI: expected remaining %v but got %v", expected, arr)
+	}
+}
+
+func TestSome(t *testing.T) {
+	t.Parallel()
+
+	if !Some([]int{1, 2, 3}, isEven) {
+		t.Errorf("Some: expected an even number to be found")
+	}
+	if Some([]int{1, 3, 5}, isEven) {
+		t.Errorf("Some: expected no even number to be found")
+	}
+	if !SomeI([]int{7, 7, 7}, func(i, _ int) bool { return i == 2 }) {
+		t.Errorf("SomeI: expected index 2 to be visited")
+	}
+}